@@ -3,8 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -15,11 +16,15 @@ import (
 
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/httpclient"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/secret"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/server"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/calendar"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/channel"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/events"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/notification"
 	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
 )
 
@@ -31,7 +36,8 @@ func main() {
 }
 
 func run() error {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	cfg, err := config.Get()
 	if err != nil {
@@ -54,39 +60,96 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	defer db.Close()
 
-	err = database.Migrate(context.Background(), db, logger)
+	err = database.Migrate(ctx, db, logger)
 	if err != nil {
 		return err
 	}
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	credentialsCipher, err := secret.NewCipher(cfg.CredentialsKey)
+	if err != nil {
+		return fmt.Errorf("loading credentials key: %w", err)
+	}
+
+	httpClient := httpclient.New(cfg.Imports)
+
+	calendarRepo := calendar.NewCalendarRepository(db, credentialsCipher, cfg.Imports.MaxIcalSizeOverride)
+	channelRepo := channel.NewChannelRepository(db)
+	eventRepo := events.NewRepository(db, cfg.Alarms.IgnoredActions)
+	notificationRepo := notification.NewRepository(db)
+	locker := database.NewLocker(db)
+	sizeLimits := events.SizeLimits{Default: cfg.Imports.MaxIcalSize, Max: cfg.Imports.MaxIcalSizeOverride}
+	sources := events.Sources{
+		HTTP:   events.NewHTTPSource(httpClient, calendarRepo, sizeLimits),
+		CalDAV: events.NewCalDAVSource(httpClient, calendarRepo, eventRepo, sizeLimits),
+		Inline: events.NewInlineSource(calendarRepo, sizeLimits),
+	}
+
+	if cfg.FileSourceRoot != "" {
+		root, err := os.OpenRoot(cfg.FileSourceRoot)
+		if err != nil {
+			return fmt.Errorf("opening file source root: %w", err)
+		}
+		defer root.Close()
 
-	calendarRepo := calendar.NewCalendarRepository(db)
-	eventRepo := events.NewRepository(db)
-	svc := service.NewICalBackend(calendarRepo)
+		sources.File = events.NewFileSource(root, sizeLimits)
+	}
+
+	calendarImport := events.NewIcalImport(
+		eventRepo, calendarRepo, sources, cfg.Alarms.IgnoredActions, cfg.Occurrences.Horizon, cfg.Imports, logger,
+	)
+	svc := service.NewICalBackend(
+		calendarRepo, channelRepo, notificationRepo, eventRepo, calendarImport, cfg.Notifications, logger,
+	)
 
 	srv := server.Server{
-		HTTPPort: cfg.HTTPPort,
-		GRPCPort: cfg.GRPCPort,
-		Logger:   logger,
+		HTTPPort:        cfg.HTTPPort,
+		GRPCPort:        cfg.GRPCPort,
+		Logger:          logger,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+		// Uploaded iCal data is limited like the feeds of calendars with the highest size limit
+		MaxRequestSize: cfg.Imports.MaxIcalSizeOverride,
 		Register: func(server *grpc.Server, conn *grpc.ClientConn, mux *runtime.ServeMux) error {
 			pb.RegisterIcalBotServiceServer(server, svc)
+
+			err := service.RegisterCalendarDataUpload(
+				mux, pb.NewIcalBotServiceClient(conn), cfg.Imports.MaxIcalSizeOverride,
+			)
+			if err != nil {
+				return err
+			}
+
 			return pb.RegisterIcalBotServiceHandler(context.Background(), mux, conn)
 		},
 		Jobs: []server.JobSpec{
 			{
-				Name:     "ical_import",
-				Job:      events.NewIcalImport(eventRepo, calendarRepo, httpClient, logger),
-				Interval: 1 * time.Minute,
+				Name:       "ical_import",
+				Job:        calendarImport,
+				Interval:   1 * time.Minute,
+				Jitter:     10 * time.Second,
+				Timeout:    10 * time.Minute,
+				RunOnStart: true,
+				Locker:     locker,
+			},
+			{
+				Name:       "occurrence_calculation",
+				Job:        events.NewOccurrenceCalculation(eventRepo, calendarRepo, cfg.Occurrences, logger),
+				Interval:   cfg.Occurrences.Interval,
+				Jitter:     time.Minute,
+				Timeout:    30 * time.Minute,
+				RunOnStart: true,
+				Locker:     locker,
 			},
 		},
 	}
 
-	err = srv.Run()
+	err = srv.Run(ctx)
 	if err != nil {
 		return err
 	}
 
+	logger.Info("shutdown complete")
+
 	return nil
 }
@@ -1,15 +1,37 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
 	"github.com/caarlos0/env/v11"
 )
 
+var ErrInvalidConfig = errors.New("invalid config")
+
 type Config struct {
 	LogLevel string `env:"ICAL_BACKEND_LOG_LEVEL" envDefault:"INFO"`
 	HTTPPort int    `env:"ICAL_BACKEND_HTTP_PORT" envDefault:"8080"`
 	GRPCPort int    `env:"ICAL_BACKEND_GRPC_PORT" envDefault:"8081"`
 
-	Database Database
+	// ShutdownTimeout should stay below the termination grace period of the container runtime
+	ShutdownTimeout time.Duration `env:"ICAL_BACKEND_SHUTDOWN_TIMEOUT" envDefault:"25s"`
+
+	// CredentialsKey is the base64 encoded 256 bit key that encrypts the stored feed credentials. Without it,
+	// calendars can't have credentials.
+	CredentialsKey string `env:"ICAL_BACKEND_CREDENTIALS_KEY"`
+
+	// FileSourceRoot is the directory that the paths of calendars with a file source are relative to. Without it,
+	// calendars can't be imported from files.
+	FileSourceRoot string `env:"ICAL_BACKEND_FILE_SOURCE_ROOT"`
+
+	Database      Database
+	Notifications Notifications
+	Occurrences   Occurrences
+	Alarms        Alarms
+	Imports       Imports
 }
 
 type Database struct {
@@ -17,6 +39,147 @@ type Database struct {
 	MaxOpenConns int    `env:"ICAL_BACKEND_DATABASE_MAX_OPEN_CONNS"`
 }
 
+type Notifications struct {
+	PollInterval time.Duration `env:"ICAL_BACKEND_NOTIFICATIONS_POLL_INTERVAL" envDefault:"10s"`
+	AckTimeout   time.Duration `env:"ICAL_BACKEND_NOTIFICATIONS_ACK_TIMEOUT" envDefault:"1m"`
+	BatchSize    int32         `env:"ICAL_BACKEND_NOTIFICATIONS_BATCH_SIZE" envDefault:"100"`
+	MaxAttempts  int32         `env:"ICAL_BACKEND_NOTIFICATIONS_MAX_ATTEMPTS" envDefault:"5"`
+	MaxDelay     time.Duration `env:"ICAL_BACKEND_NOTIFICATIONS_MAX_DELAY" envDefault:"1h"`
+}
+
+type Occurrences struct {
+	// Interval is the time between two recalculations of the alarms of all stored events
+	Interval time.Duration `env:"ICAL_BACKEND_OCCURRENCES_INTERVAL" envDefault:"1h"`
+	// Horizon is how far ahead alarms are materialized, it must be well above Interval
+	Horizon time.Duration `env:"ICAL_BACKEND_OCCURRENCES_HORIZON" envDefault:"720h"`
+	// Retention is how long alarms of past occurrences are kept
+	Retention time.Duration `env:"ICAL_BACKEND_OCCURRENCES_RETENTION" envDefault:"168h"`
+	// PageSize is the number of calendars that are listed at once when recalculating their alarms
+	PageSize int32 `env:"ICAL_BACKEND_OCCURRENCES_PAGE_SIZE" envDefault:"100"`
+}
+
+type Alarms struct {
+	// IgnoredActions are the VALARM actions, e.g. "EMAIL", whose alarms are not delivered
+	IgnoredActions []string `env:"ICAL_BACKEND_ALARMS_IGNORED_ACTIONS" envSeparator:","`
+}
+
+type Imports struct {
+	// PageSize is the number of calendars that are listed at once when looking for calendars that are due
+	PageSize int32 `env:"ICAL_BACKEND_IMPORTS_PAGE_SIZE" envDefault:"100"`
+	// Concurrency is the number of calendars that each replica imports at the same time
+	Concurrency int `env:"ICAL_BACKEND_IMPORTS_CONCURRENCY" envDefault:"8"`
+	// HTTPTimeout limits the requests to feed hosts and CalDAV servers, including reading the response
+	HTTPTimeout time.Duration `env:"ICAL_BACKEND_IMPORTS_HTTP_TIMEOUT" envDefault:"30s"`
+	// MaxRedirects is the number of redirects followed per request to a feed host
+	MaxRedirects int `env:"ICAL_BACKEND_IMPORTS_MAX_REDIRECTS" envDefault:"5"`
+	// Feeds are only fetched from public addresses. AllowedNetworks, e.g. "10.1.0.0/16", are reachable nonetheless,
+	// e.g. for on-prem CalDAV servers. DeniedNetworks are never reachable, even if they are public or allowed.
+	AllowedNetworks []netip.Prefix `env:"ICAL_BACKEND_IMPORTS_ALLOWED_NETWORKS" envSeparator:","`
+	DeniedNetworks  []netip.Prefix `env:"ICAL_BACKEND_IMPORTS_DENIED_NETWORKS" envSeparator:","`
+	// MaxIcalSize is the maximum size of a feed in bytes, unless calendars override it
+	MaxIcalSize int64 `env:"ICAL_BACKEND_IMPORTS_MAX_ICAL_SIZE" envDefault:"10485760"`
+	// MaxIcalSizeOverride is the highest size limit that calendars can override MaxIcalSize with, as feeds are read
+	// into memory. It limits the iCal data uploaded to the API as well.
+	MaxIcalSizeOverride int64 `env:"ICAL_BACKEND_IMPORTS_MAX_ICAL_SIZE_OVERRIDE" envDefault:"52428800"`
+	// MaxEvents is the maximum number of events stored per calendar, imports of feeds with more events fail. 0
+	// disables the limit.
+	MaxEvents int `env:"ICAL_BACKEND_IMPORTS_MAX_EVENTS" envDefault:"10000"`
+	// SyncInterval is the time between two syncs of calendars without their own sync interval
+	SyncInterval time.Duration `env:"ICAL_BACKEND_IMPORTS_SYNC_INTERVAL" envDefault:"5m"`
+	// MaxBackoff caps the exponential backoff of calendars whose imports fail. Retry-After headers of feed hosts are
+	// honored even if they ask for a longer delay.
+	MaxBackoff time.Duration `env:"ICAL_BACKEND_IMPORTS_MAX_BACKOFF" envDefault:"24h"`
+	// MaxRetryAfter caps the delay that feed hosts can ask for with Retry-After headers
+	MaxRetryAfter time.Duration `env:"ICAL_BACKEND_IMPORTS_MAX_RETRY_AFTER" envDefault:"168h"`
+	// HistoryRetention is how long the import history of calendars is kept
+	HistoryRetention time.Duration `env:"ICAL_BACKEND_IMPORTS_HISTORY_RETENTION" envDefault:"720h"`
+}
+
 func Get() (Config, error) {
-	return env.ParseAs[Config]()
+	cfg, err := env.ParseAs[Config]()
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = cfg.Notifications.validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = cfg.Imports.validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	err = cfg.Occurrences.validate()
+	if err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// validate rejects notification settings that would crash notification streams or keep them from delivering
+// anything instead of failing at startup.
+func (n Notifications) validate() error {
+	switch {
+	case n.PollInterval <= 0:
+		return fmt.Errorf("%w: notifications poll interval must be positive", ErrInvalidConfig)
+	case n.AckTimeout <= 0:
+		return fmt.Errorf("%w: notifications ack timeout must be positive", ErrInvalidConfig)
+	case n.BatchSize <= 0:
+		return fmt.Errorf("%w: notifications batch size must be positive", ErrInvalidConfig)
+	case n.MaxAttempts <= 0:
+		return fmt.Errorf("%w: notifications max attempts must be positive", ErrInvalidConfig)
+	case n.MaxDelay <= 0:
+		return fmt.Errorf("%w: notifications max delay must be positive", ErrInvalidConfig)
+	}
+
+	return nil
+}
+
+// validate rejects import settings that would stall or crash the import job instead of failing at startup.
+func (i Imports) validate() error {
+	switch {
+	case i.PageSize <= 0:
+		return fmt.Errorf("%w: imports page size must be positive", ErrInvalidConfig)
+	case i.Concurrency <= 0:
+		return fmt.Errorf("%w: imports concurrency must be positive", ErrInvalidConfig)
+	case i.HTTPTimeout <= 0:
+		return fmt.Errorf("%w: imports HTTP timeout must be positive", ErrInvalidConfig)
+	case i.MaxRedirects < 0:
+		return fmt.Errorf("%w: imports max redirects must not be negative", ErrInvalidConfig)
+	case i.MaxIcalSize <= 0:
+		return fmt.Errorf("%w: imports max iCal size must be positive", ErrInvalidConfig)
+	case i.MaxIcalSizeOverride < i.MaxIcalSize:
+		return fmt.Errorf("%w: imports max iCal size override must be at least the max iCal size", ErrInvalidConfig)
+	case i.MaxEvents < 0:
+		return fmt.Errorf("%w: imports max events must not be negative", ErrInvalidConfig)
+	case i.SyncInterval <= 0:
+		return fmt.Errorf("%w: imports sync interval must be positive", ErrInvalidConfig)
+	case i.MaxBackoff <= 0:
+		return fmt.Errorf("%w: imports max backoff must be positive", ErrInvalidConfig)
+	case i.MaxRetryAfter <= 0:
+		return fmt.Errorf("%w: imports max retry after must be positive", ErrInvalidConfig)
+	case i.HistoryRetention <= 0:
+		return fmt.Errorf("%w: imports history retention must be positive", ErrInvalidConfig)
+	}
+
+	return nil
+}
+
+// validate rejects occurrence settings that would keep alarms from being materialized before they fire.
+func (o Occurrences) validate() error {
+	switch {
+	case o.Interval <= 0:
+		return fmt.Errorf("%w: occurrences interval must be positive", ErrInvalidConfig)
+	case o.Horizon <= o.Interval:
+		return fmt.Errorf("%w: occurrences horizon must be longer than the interval", ErrInvalidConfig)
+	case o.Retention <= 0:
+		return fmt.Errorf("%w: occurrences retention must be positive", ErrInvalidConfig)
+	case o.PageSize <= 0:
+		return fmt.Errorf("%w: occurrences page size must be positive", ErrInvalidConfig)
+	}
+
+	return nil
 }
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	testcases := []struct {
+		Name string
+		Env  map[string]string
+
+		ExpectedErr error
+	}{{
+		Name: "Defaults",
+	}, {
+		Name:        "Zero poll interval",
+		Env:         map[string]string{"ICAL_BACKEND_NOTIFICATIONS_POLL_INTERVAL": "0s"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Negative ack timeout",
+		Env:         map[string]string{"ICAL_BACKEND_NOTIFICATIONS_ACK_TIMEOUT": "-1m"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero batch size",
+		Env:         map[string]string{"ICAL_BACKEND_NOTIFICATIONS_BATCH_SIZE": "0"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero max attempts",
+		Env:         map[string]string{"ICAL_BACKEND_NOTIFICATIONS_MAX_ATTEMPTS": "0"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero max delay",
+		Env:         map[string]string{"ICAL_BACKEND_NOTIFICATIONS_MAX_DELAY": "0s"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero concurrency",
+		Env:         map[string]string{"ICAL_BACKEND_IMPORTS_CONCURRENCY": "0"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero page size",
+		Env:         map[string]string{"ICAL_BACKEND_IMPORTS_PAGE_SIZE": "0"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Negative HTTP timeout",
+		Env:         map[string]string{"ICAL_BACKEND_IMPORTS_HTTP_TIMEOUT": "-1s"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Size limit override below the size limit",
+		Env:         map[string]string{"ICAL_BACKEND_IMPORTS_MAX_ICAL_SIZE_OVERRIDE": "1024"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero occurrences interval",
+		Env:         map[string]string{"ICAL_BACKEND_OCCURRENCES_INTERVAL": "0s"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Occurrences horizon within the interval",
+		Env:         map[string]string{"ICAL_BACKEND_OCCURRENCES_HORIZON": "1h"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Negative occurrences retention",
+		Env:         map[string]string{"ICAL_BACKEND_OCCURRENCES_RETENTION": "-1h"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name:        "Zero occurrences page size",
+		Env:         map[string]string{"ICAL_BACKEND_OCCURRENCES_PAGE_SIZE": "0"},
+		ExpectedErr: ErrInvalidConfig,
+	}, {
+		Name: "Disabled event limit",
+		Env:  map[string]string{"ICAL_BACKEND_IMPORTS_MAX_EVENTS": "0"},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			for key, value := range testcase.Env {
+				t.Setenv(key, value)
+			}
+
+			_, err := Get()
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
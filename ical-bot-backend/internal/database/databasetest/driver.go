@@ -0,0 +1,155 @@
+package databasetest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+var errPrepareUnsupported = errors.New("prepared statements are not supported")
+
+// Statement is a statement run on the fake database.
+type Statement struct {
+	// Query has its whitespace collapsed to single spaces
+	Query string
+	Args  []any
+	// InTx is set for statements run in a transaction
+	InTx bool
+}
+
+// Connector connects to a fake database that records the statements run on it, so the statements of repositories
+// can be checked without a database. A query returns the Rows of the key that is part of it, or no rows. Statements
+// change nothing, and transactions only mark their statements.
+type Connector struct {
+	// Rows are the results of queries, by a part of the query with its whitespace collapsed
+	Rows map[string][][]driver.Value
+
+	mu         sync.Mutex
+	statements []Statement
+}
+
+func (c *Connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{connector: c}, nil
+}
+
+func (c *Connector) Driver() driver.Driver {
+	return nil
+}
+
+// Statements returns the statements run so far whose query contains the given part, all of them if it's empty.
+func (c *Connector) Statements(part string) []Statement {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var statements []Statement
+
+	for _, statement := range c.statements {
+		if strings.Contains(statement.Query, part) {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements
+}
+
+func (c *Connector) record(query string, args []driver.NamedValue, inTx bool) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	query = strings.Join(strings.Fields(query), " ")
+
+	values := make([]any, 0, len(args))
+	for _, arg := range args {
+		values = append(values, arg.Value)
+	}
+
+	c.statements = append(c.statements, Statement{Query: query, Args: values, InTx: inTx})
+
+	return query
+}
+
+type conn struct {
+	connector *Connector
+	inTx      bool
+}
+
+func (c *conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errPrepareUnsupported
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	c.inTx = true
+
+	return c, nil
+}
+
+func (c *conn) Commit() error {
+	c.inTx = false
+
+	return nil
+}
+
+func (c *conn) Rollback() error {
+	c.inTx = false
+
+	return nil
+}
+
+// CheckNamedValue passes all arguments to the statements unchanged, like pgx does.
+func (c *conn) CheckNamedValue(*driver.NamedValue) error {
+	return nil
+}
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.record(query, args, c.inTx)
+
+	return driver.RowsAffected(0), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	query = c.connector.record(query, args, c.inTx)
+
+	for part, values := range c.connector.Rows {
+		if strings.Contains(query, part) {
+			return &rows{values: values}, nil
+		}
+	}
+
+	return &rows{}, nil
+}
+
+type rows struct {
+	values [][]driver.Value
+}
+
+// Columns returns placeholder names, only the number of columns matters for scanning.
+func (r *rows) Columns() []string {
+	columns := 1
+	if len(r.values) > 0 {
+		columns = len(r.values[0])
+	}
+
+	return make([]string, columns)
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+
+	return nil
+}
@@ -0,0 +1,26 @@
+// Package databasetest provides fakes of the database package for tests.
+package databasetest
+
+import (
+	"context"
+)
+
+// Locker is an in-memory database.Locker for tests that don't run concurrently. A held lock can't be acquired until
+// it is released, setting Held simulates a lock held by another replica.
+type Locker struct {
+	Held bool
+}
+
+func (l *Locker) TryLock(context.Context, string) (unlock func() error, acquired bool, err error) {
+	if l.Held {
+		return nil, false, nil
+	}
+
+	l.Held = true
+
+	return func() error {
+		l.Held = false
+
+		return nil
+	}, true, nil
+}
@@ -0,0 +1,63 @@
+package databasetest
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database"
+)
+
+// PostgresURIEnv names the environment variable with the URL of the Postgres database that Postgres connects to.
+const PostgresURIEnv = "ICAL_BACKEND_TEST_DATABASE_URI"
+
+// Postgres returns a connection to a new schema with all migrations applied, in the Postgres database given by
+// PostgresURIEnv. The schema is dropped when the test ends. Tests that depend on the semantics of a real database,
+// like row locks, use it instead of the fake driver, they are skipped if no database is given.
+func Postgres(t *testing.T) *sql.DB {
+	t.Helper()
+
+	uri := os.Getenv(PostgresURIEnv)
+	if uri == "" {
+		t.Skipf("%s is not set", PostgresURIEnv)
+	}
+
+	admin, err := sql.Open("pgx", uri)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = admin.Close() })
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	_, err = admin.Exec(`create schema ` + schema)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, err := admin.Exec(`drop schema ` + schema + ` cascade`)
+		require.NoError(t, err)
+	})
+
+	// Connections of the test only see the new schema, unqualified tables are created and found there
+	schemaURI, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	query := schemaURI.Query()
+	query.Set("search_path", schema)
+	schemaURI.RawQuery = query.Encode()
+
+	db, err := sql.Open("pgx", schemaURI.String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, database.Migrate(context.Background(), db, slog.New(slog.DiscardHandler)))
+
+	return db
+}
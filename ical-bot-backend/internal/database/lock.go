@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Locker provides mutual exclusion between replicas using Postgres transaction-level advisory locks.
+type Locker struct {
+	db *sql.DB
+}
+
+func NewLocker(db *sql.DB) *Locker {
+	return &Locker{db: db}
+}
+
+// TryLock acquires the advisory lock identified by key without waiting for it. If the lock is held by another
+// session, acquired is false. The lock is held by a transaction of its own until unlock is called, or until its
+// connection is lost, so work that runs on the database should rather take the lock with TryLockTx on its own
+// transaction.
+func (l *Locker) TryLock(ctx context.Context, key string) (unlock func() error, acquired bool, err error) {
+	// The transaction must outlive ctx, it would be rolled back when ctx is cancelled otherwise
+	tx, err := l.db.BeginTx(context.WithoutCancel(ctx), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	acquired, err = TryLockTx(ctx, tx, key)
+	if err != nil || !acquired {
+		_ = tx.Rollback()
+
+		return nil, false, err
+	}
+
+	return tx.Rollback, true, nil
+}
+
+// TryLockTx acquires the advisory lock identified by key on the transaction without waiting for it. If the lock is
+// held by another session, acquired is false. The lock is released when the transaction ends.
+func TryLockTx(ctx context.Context, tx *sql.Tx, key string) (acquired bool, err error) {
+	err = tx.QueryRowContext(ctx, `select pg_try_advisory_xact_lock(hashtextextended($1, 0))`, key).Scan(&acquired)
+
+	return acquired, err
+}
@@ -0,0 +1,15 @@
+delete from calendar_channels
+where calendar_id is null or channel_id is null;
+
+delete from calendar_channels a
+    using calendar_channels b
+where a.ctid < b.ctid
+  and a.calendar_id = b.calendar_id
+  and a.channel_id = b.channel_id;
+
+alter table calendar_channels
+    alter column calendar_id set not null,
+    alter column channel_id set not null,
+    add primary key (calendar_id, channel_id);
+
+create index calendar_channels_channel_id_idx on calendar_channels (channel_id, calendar_id);
@@ -0,0 +1,3 @@
+alter table calendars
+    add column http_etag          text null,
+    add column http_last_modified text null;
@@ -0,0 +1,16 @@
+-- Events were re-created with new IDs on every import, so there is no identity worth keeping. Dropping them and the
+-- stored feed versions makes the next import recreate them keyed by UID.
+delete from calendar_events;
+
+update calendars
+set last_sync_hash     = null,
+    http_etag          = null,
+    http_last_modified = null;
+
+alter table calendar_events
+    add column uid           text not null,
+    add column recurrence_id text not null default '',
+    add constraint calendar_events_uid_key unique (calendar_id, uid, recurrence_id);
+
+alter table calendar_event_alarms
+    add constraint calendar_event_alarms_time_key unique (event_id, alarm_time, event_time);
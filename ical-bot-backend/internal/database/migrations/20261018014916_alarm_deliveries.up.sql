@@ -0,0 +1,18 @@
+create table calendar_event_alarm_deliveries
+(
+    alarm_id       uuid        not null references calendar_event_alarms (id) on delete cascade,
+    channel_id     uuid        not null references channels (id) on delete cascade,
+    state          text        not null default 'pending',
+    lease_expiry   timestamptz null,
+    attempts       integer     not null default 0,
+    last_error     text        null,
+    delivered_time timestamptz null,
+    updated_time   timestamptz not null default now(),
+    primary key (alarm_id, channel_id)
+);
+
+create index calendar_event_alarm_deliveries_open_idx
+    on calendar_event_alarm_deliveries (state, lease_expiry)
+    where state in ('pending', 'in_flight');
+
+create index calendar_event_alarms_alarm_time_idx on calendar_event_alarms (alarm_time);
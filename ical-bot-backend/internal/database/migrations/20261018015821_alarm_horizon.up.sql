@@ -0,0 +1,8 @@
+-- Events without alarms in the next few occurrences weren't stored before, so the alarm calculation can't extend
+-- them. Dropping the stored feed versions makes the next import store them.
+update calendars
+set last_sync_hash     = null,
+    http_etag          = null,
+    http_last_modified = null;
+
+create index calendar_event_alarms_event_time_idx on calendar_event_alarms (event_time);
@@ -0,0 +1,2 @@
+alter table calendars
+    add column time_zone text not null default '';
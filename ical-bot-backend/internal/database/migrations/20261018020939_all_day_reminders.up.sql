@@ -0,0 +1,9 @@
+create table calendar_all_day_reminders
+(
+    id          uuid     not null default gen_random_uuid() primary key,
+    calendar_id uuid     not null references calendars (id) on delete cascade,
+    days_before integer  not null default 0,
+    time_of_day interval not null default '0 sec'::interval
+);
+
+create index calendar_all_day_reminders_calendar_id_idx on calendar_all_day_reminders (calendar_id);
@@ -0,0 +1,2 @@
+alter table calendars
+    add column credentials_encrypted bytea null;
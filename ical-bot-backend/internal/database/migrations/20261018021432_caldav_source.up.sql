@@ -0,0 +1,4 @@
+alter table calendars
+    add column caldav_url        text null,
+    add column caldav_sync_token text null,
+    add column caldav_ctag       text null;
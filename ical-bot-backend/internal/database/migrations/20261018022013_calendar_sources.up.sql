@@ -0,0 +1,8 @@
+alter table calendars
+    add column source_type text  not null default 'http',
+    add column file_path   text  null,
+    add column inline_data bytea null;
+
+update calendars
+set source_type = 'caldav'
+where caldav_url is not null;
@@ -0,0 +1,19 @@
+create table calendar_imports
+(
+    id               uuid        not null primary key,
+    calendar_id      uuid        not null references calendars (id) on delete cascade,
+    start_time       timestamptz not null,
+    end_time         timestamptz not null,
+    http_status      integer     null,
+    bytes_fetched    bigint      not null default 0,
+    unchanged        boolean     not null default false,
+    events_added     integer     not null default 0,
+    events_updated   integer     not null default 0,
+    events_removed   integer     not null default 0,
+    alarms_scheduled integer     not null default 0,
+    error_pb         bytea       null
+);
+
+-- The IDs are UUIDv7, ordering by them lists the imports of a calendar from the newest to the oldest
+create index calendar_imports_calendar_id_id_idx on calendar_imports (calendar_id, id desc);
+create index calendar_imports_start_time_idx on calendar_imports (start_time);
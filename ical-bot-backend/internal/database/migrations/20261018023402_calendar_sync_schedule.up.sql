@@ -0,0 +1,11 @@
+alter table calendars
+    add column sync_interval  interval    null,
+    add column next_sync_time timestamptz null,
+    add column sync_failures  integer     not null default 0;
+
+-- Calendars used to be synced every 5 minutes, calendars that were never synced are due right away
+update calendars
+set next_sync_time = last_sync_time + interval '5 minutes'
+where last_sync_time is not null;
+
+create index calendars_next_sync_time_idx on calendars (next_sync_time);
@@ -0,0 +1,2 @@
+alter table calendars
+    add column max_ical_size bigint null;
@@ -0,0 +1,9 @@
+-- Overrides of occurrences that ended are no longer stored, so the recurring event keeps the RECURRENCE-IDs of all
+-- its overridden occurrences. Forgetting the stored feed versions makes the next import fill them in.
+alter table calendar_events
+    add column overridden_occurrences timestamptz[] not null default '{}';
+
+update calendars
+set last_sync_hash     = null,
+    http_etag          = null,
+    http_last_modified = null;
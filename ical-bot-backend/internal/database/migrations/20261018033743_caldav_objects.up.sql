@@ -0,0 +1,14 @@
+-- The calendar objects of CalDAV collections are kept, so the changes of sync-collection REPORTs can be applied to
+-- them. Forgetting the stored versions makes the next sync query the collections in full, which fills them in.
+create table calendar_caldav_objects
+(
+    calendar_id uuid not null references calendars (id) on delete cascade,
+    href        text not null,
+    data        text not null,
+    primary key (calendar_id, href)
+);
+
+update calendars
+set caldav_sync_token = null,
+    caldav_ctag       = null,
+    last_sync_hash    = null;
@@ -0,0 +1,115 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+)
+
+var (
+	ErrAddressNotAllowed = errors.New("address is not allowed")
+	ErrTooManyRedirects  = errors.New("too many redirects")
+	ErrSchemeNotAllowed  = errors.New("redirect scheme is not allowed")
+)
+
+// nonPublicPrefixes are the special purpose networks (RFC 6890) that are not reachable on the internet, besides the
+// private, loopback, link-local and multicast networks that netip.Addr reports. The IPv6 transition networks embed
+// IPv4 addresses that may be private, so they are not public either.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/32"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// New creates the client that fetches the user-supplied URLs of feeds. It only connects to public addresses, which
+// are checked after DNS resolution for every connection, so neither redirects nor DNS records can point it at cloud
+// metadata endpoints or internal services. Networks can be allowed and denied explicitly, e.g. for on-prem CalDAV
+// servers. Proxies from the environment are not used, as the addresses they connect to can't be checked.
+func New(cfg config.Imports) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   cfg.HTTPTimeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkAddress(address, cfg.AllowedNetworks, cfg.DeniedNetworks)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // always a *http.Transport
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   cfg.HTTPTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > cfg.MaxRedirects {
+				return fmt.Errorf("%w: more than %d", ErrTooManyRedirects, cfg.MaxRedirects)
+			}
+
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("%w: %q", ErrSchemeNotAllowed, req.URL.Scheme)
+			}
+
+			return nil
+		},
+	}
+}
+
+// checkAddress checks that the address, an IP and port, may be connected to. Denied networks take precedence over
+// allowed ones, which take precedence over the check for public addresses.
+func checkAddress(address string, allowed, denied []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAddressNotAllowed, err)
+	}
+
+	// IPv4-mapped IPv6 addresses reach the IPv4 address
+	addr := addrPort.Addr().Unmap().WithZone("")
+
+	for _, prefix := range denied {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("%w: %s", ErrAddressNotAllowed, addr)
+		}
+	}
+
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+
+	if !isPublic(addr) {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, addr)
+	}
+
+	return nil
+}
+
+// isPublic reports whether the address is reachable on the internet.
+func isPublic(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
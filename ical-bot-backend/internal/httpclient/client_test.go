@@ -0,0 +1,181 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+)
+
+func TestCheckAddress(t *testing.T) {
+	testcases := []struct {
+		Name    string
+		Address string
+		Allowed []netip.Prefix
+		Denied  []netip.Prefix
+
+		ExpectedAllowed bool
+	}{{
+		Name:            "Public IPv4",
+		Address:         "93.184.215.14:443",
+		ExpectedAllowed: true,
+	}, {
+		Name:            "Public IPv6",
+		Address:         "[2606:4700::6810:84e5]:443",
+		ExpectedAllowed: true,
+	}, {
+		Name:    "Loopback",
+		Address: "127.0.0.1:80",
+	}, {
+		Name:    "IPv6 loopback",
+		Address: "[::1]:80",
+	}, {
+		Name:    "Private",
+		Address: "10.0.0.5:80",
+	}, {
+		Name:    "Unique local",
+		Address: "[fd12:3456::1]:80",
+	}, {
+		Name:    "Cloud metadata endpoint",
+		Address: "169.254.169.254:80",
+	}, {
+		Name:    "IPv6 link-local",
+		Address: "[fe80::1%eth0]:80",
+	}, {
+		Name:    "IPv4-mapped private",
+		Address: "[::ffff:192.168.1.1]:80",
+	}, {
+		Name:    "Unspecified",
+		Address: "0.0.0.0:80",
+	}, {
+		Name:    "Shared address space",
+		Address: "100.64.0.1:80",
+	}, {
+		Name:    "NAT64",
+		Address: "[64:ff9b::a00:5]:80",
+	}, {
+		Name:    "6to4 with a private IPv4 address",
+		Address: "[2002:a00:5::1]:80",
+	}, {
+		Name:    "Teredo",
+		Address: "[2001:0:4136:e378:8000:63bf:3fff:fdd2]:80",
+	}, {
+		Name:    "IPv6 documentation",
+		Address: "[2001:db8::1]:80",
+	}, {
+		Name:            "Public IPv6 next to Teredo",
+		Address:         "[2001:4860:4860::8888]:443",
+		ExpectedAllowed: true,
+	}, {
+		Name:            "Allowed private network",
+		Address:         "10.1.2.3:443",
+		Allowed:         []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")},
+		ExpectedAllowed: true,
+	}, {
+		Name:    "Private address outside the allowed network",
+		Address: "10.2.0.1:443",
+		Allowed: []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")},
+	}, {
+		Name:    "Denied public network",
+		Address: "93.184.215.14:443",
+		Denied:  []netip.Prefix{netip.MustParsePrefix("93.184.215.0/24")},
+	}, {
+		Name:    "Denied takes precedence",
+		Address: "10.1.2.3:443",
+		Allowed: []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")},
+		Denied:  []netip.Prefix{netip.MustParsePrefix("10.1.2.0/24")},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			err := checkAddress(testcase.Address, testcase.Allowed, testcase.Denied)
+			if testcase.ExpectedAllowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrAddressNotAllowed)
+			}
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/calendar.ics", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/redirect/{n}", func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(r.PathValue("n"))
+		if n == 0 {
+			http.Redirect(w, r, "/calendar.ics", http.StatusFound)
+
+			return
+		}
+
+		http.Redirect(w, r, "/redirect/"+strconv.Itoa(n-1), http.StatusFound)
+	})
+	mux.HandleFunc("/file", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	loopback := []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+	testcases := []struct {
+		Name    string
+		Path    string
+		Allowed []netip.Prefix
+
+		ExpectedErr error
+	}{{
+		Name:        "Loopback",
+		Path:        "/calendar.ics",
+		ExpectedErr: ErrAddressNotAllowed,
+	}, {
+		Name:    "Allowed loopback",
+		Path:    "/calendar.ics",
+		Allowed: loopback,
+	}, {
+		Name:    "Redirects",
+		Path:    "/redirect/2",
+		Allowed: loopback,
+	}, {
+		Name:        "Too many redirects",
+		Path:        "/redirect/3",
+		Allowed:     loopback,
+		ExpectedErr: ErrTooManyRedirects,
+	}, {
+		Name:        "Redirect to a file",
+		Path:        "/file",
+		Allowed:     loopback,
+		ExpectedErr: ErrSchemeNotAllowed,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			client := New(config.Imports{HTTPTimeout: 5 * time.Second, MaxRedirects: 3, AllowedNetworks: testcase.Allowed})
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+testcase.Path, http.NoBody)
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNoKey         = errors.New("no encryption key configured")
+	ErrInvalidKey    = errors.New("encryption key must be 32 bytes, base64 encoded")
+	ErrDecryptFailed = errors.New("decrypting secret failed")
+)
+
+// Cipher encrypts secrets, like feed credentials, before they are stored in the database. It uses AES-256-GCM, the
+// nonce is stored in front of the ciphertext.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher from a base64 encoded 256 bit key. An empty key returns a cipher that refuses to
+// encrypt or decrypt anything, so deployments without stored secrets don't need a key.
+func NewCipher(key string) (*Cipher, error) {
+	if key == "" {
+		return &Cipher{}, nil
+	}
+
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(rawKey) != 32 {
+		return nil, ErrInvalidKey
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt encrypts the plaintext. The additional data, e.g. the ID of the owning row, isn't stored but must be
+// passed to Decrypt again, so a ciphertext can't be moved to another row.
+func (c *Cipher) Encrypt(plaintext, additionalData []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrNoKey
+	}
+
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(plaintext)+c.aead.Overhead())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func (c *Cipher) Decrypt(ciphertext, additionalData []byte) ([]byte, error) {
+	if c.aead == nil {
+		return nil, ErrNoKey
+	}
+
+	if len(ciphertext) < c.aead.NonceSize() {
+		return nil, ErrDecryptFailed
+	}
+
+	nonce, sealed := ciphertext[:c.aead.NonceSize()], ciphertext[c.aead.NonceSize():]
+
+	plaintext, err := c.aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+	}
+
+	return plaintext, nil
+}
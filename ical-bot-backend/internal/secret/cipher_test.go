@@ -0,0 +1,40 @@
+package secret
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCipher(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	c, err := NewCipher(key)
+	require.NoError(t, err)
+
+	ciphertext, err := c.Encrypt([]byte("hunter2"), []byte("calendar-1"))
+	require.NoError(t, err)
+	require.NotContains(t, string(ciphertext), "hunter2")
+
+	plaintext, err := c.Decrypt(ciphertext, []byte("calendar-1"))
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", string(plaintext))
+
+	_, err = c.Decrypt(ciphertext, []byte("calendar-2"))
+	require.ErrorIs(t, err, ErrDecryptFailed)
+
+	_, err = c.Decrypt(ciphertext[:4], []byte("calendar-1"))
+	require.ErrorIs(t, err, ErrDecryptFailed)
+}
+
+func TestNewCipher(t *testing.T) {
+	_, err := NewCipher("c2hvcnQ=")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	c, err := NewCipher("")
+	require.NoError(t, err)
+
+	_, err = c.Encrypt([]byte("hunter2"), nil)
+	require.ErrorIs(t, err, ErrNoKey)
+}
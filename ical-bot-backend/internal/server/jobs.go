@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
+)
+
+var ErrJobPanic = errors.New("job panicked")
+
+type JobSpec struct {
+	Name string
+	Job  Job
+	// Interval is the time between the starts of two runs. A run that takes longer than the interval delays the
+	// next run, runs of the same job never overlap.
+	Interval time.Duration
+	// Jitter is the maximum random duration added to each interval, so replicas don't run their jobs in lockstep
+	Jitter time.Duration
+	// Timeout limits the duration of a single run, zero means no limit
+	Timeout time.Duration
+	// RunOnStart runs the job right after start instead of waiting for the first interval
+	RunOnStart bool
+	// Locker, if set, ensures that only one replica runs the job at a time. A run is skipped if another replica
+	// holds the lock.
+	Locker Locker
+}
+
+type Job interface {
+	Run(ctx context.Context) error
+}
+
+type Locker interface {
+	TryLock(ctx context.Context, key string) (unlock func() error, acquired bool, err error)
+}
+
+// runJob runs the job periodically until ctx is cancelled. The runs themselves use runCtx, so a run that is in
+// progress when ctx is cancelled can still finish. Job failures are logged with the number of consecutive failures,
+// but don't stop the schedule.
+func (s *Server) runJob(ctx, runCtx context.Context, spec JobSpec) {
+	logger := s.Logger.With(slog.String("job", spec.Name))
+
+	delay := spec.nextDelay()
+	if spec.RunOnStart {
+		delay = 0
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var failures int
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		start := time.Now()
+		skipped, err := runLocked(runCtx, spec)
+		elapsed := time.Since(start)
+
+		switch {
+		case err != nil:
+			failures++
+
+			logger.ErrorContext(ctx, "job failure", log.Error(err), slog.Duration("duration", elapsed),
+				slog.Int("consecutive_failures", failures))
+		case skipped:
+			logger.DebugContext(ctx, "job skipped, running on another replica")
+		default:
+			failures = 0
+
+			logger.DebugContext(ctx, "job finished", slog.Duration("duration", elapsed))
+		}
+
+		timer.Reset(max(spec.nextDelay()-elapsed, 0))
+	}
+}
+
+// runLocked runs the job once while holding its lock. It reports whether the run was skipped because the lock is
+// held by another replica.
+func runLocked(ctx context.Context, spec JobSpec) (skipped bool, err error) {
+	if spec.Locker == nil {
+		return false, runOnce(ctx, spec)
+	}
+
+	unlock, acquired, err := spec.Locker.TryLock(ctx, "job:"+spec.Name)
+	if err != nil {
+		return false, fmt.Errorf("acquiring job lock: %w", err)
+	}
+
+	if !acquired {
+		return true, nil
+	}
+
+	err = runOnce(ctx, spec)
+
+	unlockErr := unlock()
+	if unlockErr != nil {
+		unlockErr = fmt.Errorf("releasing job lock: %w", unlockErr)
+	}
+
+	return false, errors.Join(err, unlockErr)
+}
+
+func runOnce(ctx context.Context, spec JobSpec) (err error) {
+	if spec.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, spec.Timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrJobPanic, r)
+		}
+	}()
+
+	return spec.Job.Run(ctx)
+}
+
+func (spec JobSpec) nextDelay() time.Duration {
+	if spec.Jitter <= 0 {
+		return spec.Interval
+	}
+
+	return spec.Interval + rand.N(spec.Jitter) //nolint:gosec // jitter doesn't need a secure random source
+}
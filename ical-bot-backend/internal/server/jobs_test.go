@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database/databasetest"
+)
+
+type jobFunc func(ctx context.Context) error
+
+func (f jobFunc) Run(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestRunJob(t *testing.T) {
+	s := &Server{Logger: slog.New(slog.DiscardHandler)}
+
+	var (
+		runs    atomic.Int32
+		running atomic.Int32
+		overlap atomic.Bool
+	)
+
+	job := jobFunc(func(context.Context) error {
+		if running.Add(1) > 1 {
+			overlap.Store(true)
+		}
+		defer running.Add(-1)
+
+		time.Sleep(5 * time.Millisecond)
+
+		if runs.Add(1)%2 == 0 {
+			return errors.New("every other run fails")
+		}
+
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		s.runJob(ctx, context.Background(), JobSpec{Name: "test", Job: job, Interval: time.Millisecond, RunOnStart: true})
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return runs.Load() >= 5 }, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job schedule didn't stop after cancellation")
+	}
+
+	require.False(t, overlap.Load(), "job runs overlapped")
+}
+
+// failureLog records the consecutive failures logged by job runs.
+type failureLog struct {
+	slog.Handler
+
+	mu       sync.Mutex
+	failures []int64
+}
+
+func (l *failureLog) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (l *failureLog) Handle(_ context.Context, record slog.Record) error {
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "consecutive_failures" {
+			l.mu.Lock()
+			l.failures = append(l.failures, attr.Value.Int64())
+			l.mu.Unlock()
+		}
+
+		return true
+	})
+
+	return nil
+}
+
+func (l *failureLog) WithAttrs([]slog.Attr) slog.Handler {
+	return l
+}
+
+func (l *failureLog) Failures() []int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.Clone(l.failures)
+}
+
+func TestRunJob_CountsConsecutiveFailures(t *testing.T) {
+	logs := &failureLog{Handler: slog.DiscardHandler}
+	s := &Server{Logger: slog.New(logs)}
+
+	var runs atomic.Int32
+
+	// The third run succeeds, which resets the count
+	job := jobFunc(func(context.Context) error {
+		if runs.Add(1) == 3 {
+			return nil
+		}
+
+		return errors.New("run fails")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		s.runJob(ctx, context.Background(), JobSpec{Name: "test", Job: job, Interval: time.Millisecond, RunOnStart: true})
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return len(logs.Failures()) >= 4 }, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+
+	require.Equal(t, []int64{1, 2, 1, 2}, logs.Failures()[:4])
+}
+
+func TestRunJob_FinishesRunningJob(t *testing.T) {
+	s := &Server{Logger: slog.New(slog.DiscardHandler)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	finished := make(chan error, 1)
+
+	job := jobFunc(func(runCtx context.Context) error {
+		close(started)
+		cancel()
+		time.Sleep(5 * time.Millisecond)
+		finished <- runCtx.Err()
+
+		return nil
+	})
+
+	s.runJob(ctx, context.Background(), JobSpec{Name: "test", Job: job, Interval: time.Hour, RunOnStart: true})
+
+	<-started
+	require.NoError(t, <-finished, "running job was cancelled with the schedule")
+}
+
+func TestRunLocked(t *testing.T) {
+	var runs int
+
+	spec := JobSpec{
+		Job: jobFunc(func(context.Context) error {
+			runs++
+
+			return nil
+		}),
+		Locker: &databasetest.Locker{Held: true},
+	}
+
+	skipped, err := runLocked(context.Background(), spec)
+	require.NoError(t, err)
+	require.True(t, skipped)
+	require.Zero(t, runs)
+
+	locker := &databasetest.Locker{}
+	spec.Locker = locker
+
+	skipped, err = runLocked(context.Background(), spec)
+	require.NoError(t, err)
+	require.False(t, skipped)
+	require.Equal(t, 1, runs)
+	require.False(t, locker.Held, "lock wasn't released")
+}
+
+func TestRunOnce(t *testing.T) {
+	t.Run("Timeout", func(t *testing.T) {
+		err := runOnce(context.Background(), JobSpec{
+			Timeout: time.Millisecond,
+			Job: jobFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+
+				return ctx.Err()
+			}),
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		err := runOnce(context.Background(), JobSpec{
+			Job: jobFunc(func(context.Context) error {
+				panic("boom")
+			}),
+		})
+		require.ErrorIs(t, err, ErrJobPanic)
+	})
+}
+
+func TestNextDelay(t *testing.T) {
+	spec := JobSpec{Interval: time.Minute, Jitter: 10 * time.Second}
+
+	for range 100 {
+		delay := spec.nextDelay()
+		require.GreaterOrEqual(t, delay, time.Minute)
+		require.Less(t, delay, time.Minute+10*time.Second)
+	}
+
+	require.Equal(t, time.Minute, JobSpec{Interval: time.Minute}.nextDelay())
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -18,15 +19,8 @@ import (
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
 )
 
-type JobSpec struct {
-	Name     string
-	Job      Job
-	Interval time.Duration
-}
-
-type Job interface {
-	Run(ctx context.Context) error
-}
+// requestOverhead is the room left in gRPC requests for the fields around the largest data they carry.
+const requestOverhead = 1024 * 1024
 
 type Server struct {
 	HTTPPort int
@@ -34,9 +28,15 @@ type Server struct {
 	Logger   *slog.Logger
 	Register func(*grpc.Server, *grpc.ClientConn, *runtime.ServeMux) error
 	Jobs     []JobSpec
+	// MaxRequestSize is the size of the largest data in gRPC requests, e.g. uploaded iCal data. Requests may be
+	// larger by the encoding of their other fields.
+	MaxRequestSize int64
+	// ShutdownTimeout limits how long Run waits for open requests, streams and running jobs to finish after ctx is
+	// cancelled, before they are cancelled as well
+	ShutdownTimeout time.Duration
 }
 
-func (s *Server) Run() error {
+func (s *Server) Run(ctx context.Context) error {
 	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.GRPCPort))
 	if err != nil {
 		return err
@@ -63,11 +63,20 @@ func (s *Server) Run() error {
 		Handler: serveMux,
 	}
 
-	server := grpc.NewServer()
+	// Streams never end on their own, so their contexts are cancelled as soon as the shutdown starts to let
+	// GracefulStop drain them
+	streamCtx, cancelStreams := context.WithCancel(context.Background())
+	defer cancelStreams()
+
+	server := grpc.NewServer(
+		grpc.StreamInterceptor(shutdownStreamInterceptor(streamCtx)),
+		grpc.MaxRecvMsgSize(int(s.MaxRequestSize+requestOverhead)), //nolint:gosec // limited by the configuration
+	)
 	grpcClient, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.GRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return err
 	}
+	defer grpcClient.Close()
 
 	err = s.Register(server, grpcClient, serveMux)
 	if err != nil {
@@ -76,7 +85,13 @@ func (s *Server) Run() error {
 
 	reflection.Register(server)
 
-	eg := errgroup.Group{}
+	eg, egCtx := errgroup.WithContext(ctx)
+
+	// Running jobs are not interrupted when the shutdown starts, only when the shutdown timeout expires
+	jobCtx, cancelJobs := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelJobs()
+
+	var jobs sync.WaitGroup
 
 	eg.Go(func() error {
 		s.Logger.Info("serving gRPC", "addr", grpcListener.Addr().String())
@@ -103,23 +118,104 @@ func (s *Server) Run() error {
 	})
 
 	for _, jobSpec := range s.Jobs {
+		jobs.Add(1)
+
 		eg.Go(func() error {
-			ticker := time.NewTicker(jobSpec.Interval)
-			defer ticker.Stop()
+			defer jobs.Done()
 
-			select {
-			case <-ticker.C:
-				err := jobSpec.Job.Run(context.Background())
-				if err != nil {
-					s.Logger.Error("job failure", log.Error(err), slog.String("job", jobSpec.Name))
-
-					return err
-				}
-			}
+			s.runJob(egCtx, jobCtx, jobSpec)
 
 			return nil
 		})
 	}
 
+	eg.Go(func() error {
+		<-egCtx.Done()
+
+		s.Logger.Info("shutting down", slog.Duration("timeout", s.ShutdownTimeout))
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.ShutdownTimeout)
+		defer cancel()
+
+		cancelStreams()
+
+		var shutdown sync.WaitGroup
+
+		shutdown.Add(3)
+
+		go func() {
+			defer shutdown.Done()
+
+			err := httpServer.Shutdown(shutdownCtx)
+			if err != nil {
+				s.Logger.Warn("http server didn't shut down gracefully", log.Error(err))
+				_ = httpServer.Close()
+			}
+		}()
+
+		go func() {
+			defer shutdown.Done()
+
+			if !waitUntil(shutdownCtx, server.GracefulStop) {
+				s.Logger.Warn("gRPC server didn't shut down gracefully")
+				server.Stop()
+			}
+		}()
+
+		go func() {
+			defer shutdown.Done()
+
+			if !waitUntil(shutdownCtx, jobs.Wait) {
+				s.Logger.Warn("jobs didn't finish before the shutdown timeout, cancelling them")
+				cancelJobs()
+			}
+		}()
+
+		shutdown.Wait()
+
+		return nil
+	})
+
 	return eg.Wait()
 }
+
+// waitUntil calls f and waits for it to return until ctx is done. It reports whether f returned in time.
+func waitUntil(ctx context.Context, f func()) bool {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		f()
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// shutdownStreamInterceptor cancels the context of all streams once shutdownCtx is cancelled. A blocked Recv isn't
+// interrupted by that, so stream handlers must return on ctx.Done() without waiting for Recv.
+func shutdownStreamInterceptor(shutdownCtx context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(ss.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(shutdownCtx, cancel)
+		defer stop()
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/notification"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+func TestRun_Shutdown(t *testing.T) {
+	started := make(chan struct{})
+	finished := make(chan error, 1)
+
+	s := &Server{
+		Logger:          slog.New(slog.DiscardHandler),
+		ShutdownTimeout: time.Second,
+		Register: func(*grpc.Server, *grpc.ClientConn, *runtime.ServeMux) error {
+			return nil
+		},
+		Jobs: []JobSpec{{
+			Name: "test",
+			Job: jobFunc(func(ctx context.Context) error {
+				close(started)
+				time.Sleep(20 * time.Millisecond)
+				finished <- ctx.Err()
+
+				return nil
+			}),
+			Interval:   time.Hour,
+			RunOnStart: true,
+		}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server didn't shut down")
+	}
+
+	require.NoError(t, <-finished, "running job was cancelled before the shutdown timeout")
+}
+
+// idleNotifications has no notifications due, it reports when the first stream polls for them.
+type idleNotifications struct {
+	polled chan struct{}
+	once   sync.Once
+}
+
+func (i *idleNotifications) LeaseDue(
+	context.Context, time.Time, notification.LeaseOptions,
+) ([]*pb.EventNotification, error) {
+	i.once.Do(func() {
+		close(i.polled)
+	})
+
+	return nil, nil
+}
+
+func (i *idleNotifications) Acknowledge(context.Context, string, []string, []*pb.DeliveryFailure, int32) error {
+	return nil
+}
+
+func (i *idleNotifications) Release(context.Context, map[string][]string) error {
+	return nil
+}
+
+func TestRun_ShutdownWithOpenStream(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcPort := listener.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert // always a TCP listener
+	require.NoError(t, listener.Close())
+
+	notifications := &idleNotifications{polled: make(chan struct{})}
+	backend := service.NewICalBackend(nil, nil, notifications, nil, nil, config.Notifications{
+		PollInterval: time.Hour,
+		AckTimeout:   time.Minute,
+		BatchSize:    100,
+	}, slog.New(slog.DiscardHandler))
+
+	shutdownTimeout := 10 * time.Second
+	s := &Server{
+		GRPCPort:        grpcPort,
+		Logger:          slog.New(slog.DiscardHandler),
+		ShutdownTimeout: shutdownTimeout,
+		Register: func(server *grpc.Server, _ *grpc.ClientConn, _ *runtime.ServeMux) error {
+			pb.RegisterIcalBotServiceServer(server, backend)
+
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	// The bot keeps its side of the stream open, so the handler is blocked in Recv during the shutdown
+	require.Eventually(t, func() bool {
+		_, err := pb.NewIcalBotServiceClient(conn).StreamEventNotifications(context.Background())
+
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case <-notifications.polled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream wasn't opened")
+	}
+
+	start := time.Now()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(shutdownTimeout):
+		t.Fatal("server didn't shut down")
+	}
+
+	require.Less(t, time.Since(start), shutdownTimeout/2, "open stream wasn't drained before the shutdown timeout")
+}
@@ -4,24 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/net/http/httpguts"
 	pbStatus "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/secret"
 	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidTimeZone = errors.New("invalid time zone")
+	ErrInvalidReminder = errors.New("invalid reminder")
+	// ErrInvalidCredentials is returned for feed credentials that can't be sent as HTTP headers
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrInvalidSyncInterval = errors.New("invalid sync interval")
+	ErrInvalidSizeLimit    = errors.New("invalid size limit")
+)
+
+// minSyncInterval keeps calendars from being synced more often than the import job runs.
+const minSyncInterval = time.Minute
 
 type Repository struct {
 	db *sql.DB
+	// cipher encrypts the feed credentials
+	cipher *secret.Cipher
+	// maxIcalSize caps the size limits of calendars
+	maxIcalSize int64
 }
 
 type DefaultReminderRecord struct {
@@ -30,40 +49,61 @@ type DefaultReminderRecord struct {
 	Before     pgtype.Interval `json:"before"`
 }
 
-func NewCalendarRepository(db *sql.DB) *Repository {
-	return &Repository{db: db}
+func NewCalendarRepository(db *sql.DB, cipher *secret.Cipher, maxIcalSize int64) *Repository {
+	return &Repository{db: db, cipher: cipher, maxIcalSize: maxIcalSize}
 }
 
 func (c *Repository) CreateCalendar(ctx context.Context, calendar *pb.Calendar) (*pb.Calendar, error) {
 	calendar.Id = uuid.New().String()
 
-	tx, err := c.db.BeginTx(ctx, nil)
+	err := validateTimeZone(calendar.TimeZone)
 	if err != nil {
 		return nil, err
 	}
 
-	defer tx.Rollback()
+	err = validateAllDayReminders(calendar.AllDayReminders)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err = tx.ExecContext(ctx, `
-		insert into calendars (id, name, ical_url, default_reminder_mode)
-		values ($1, $2, $3, $4);
-	`, calendar.Id, calendar.Name, calendar.IcalUrl, calendar.DefaultReminderMode.String())
+	err = validateSyncInterval(calendar.SyncInterval)
 	if err != nil {
 		return nil, err
 	}
 
-	defaultRemindersQuery := sq.Insert("calendar_default_reminders").Columns("id", "calendar_id", "before")
+	err = validateSizeLimit(calendar.MaxIcalSize, c.maxIcalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := c.encryptCredentials(calendar.Id, calendar.Credentials)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, defaultReminders := range calendar.DefaultReminders {
-		defaultRemindersQuery.Values(defaultReminders.Id, calendar.Id, defaultReminders.Before.AsDuration())
+	source := sourceColumnsOf(calendar)
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	query, val, err := defaultRemindersQuery.PlaceholderFormat(sq.Dollar).ToSql()
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		insert into calendars (
+			id, name, default_reminder_mode, time_zone, credentials_encrypted,
+			source_type, ical_url, caldav_url, file_path, inline_data, sync_interval, max_ical_size
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, nullif($12::bigint, 0));
+	`, calendar.Id, calendar.Name, calendar.DefaultReminderMode.String(), calendar.TimeZone, credentials,
+		source.sourceType, source.icalURL, source.caldavURL, source.filePath, source.inlineData,
+		syncIntervalColumn(calendar.SyncInterval), calendar.MaxIcalSize)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = tx.ExecContext(ctx, query, val...)
+	err = replaceReminders(ctx, tx, calendar.Id, calendar.DefaultReminders, calendar.AllDayReminders)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +120,10 @@ func (c *Repository) GetCalendar(
 	ctx context.Context, id string,
 ) (*pb.Calendar, error) {
 	calendar, err := scanCalendar(c.db.QueryRowContext(ctx, `
-		select c.id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode
+		select c.id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode,
+			http_etag, http_last_modified, time_zone, credentials_encrypted is not null,
+			source_type, caldav_url, caldav_sync_token, caldav_ctag, file_path, sync_interval, next_sync_time,
+			sync_failures, max_ical_size
 		from calendars c
 		where c.id = $1
 	`, id))
@@ -88,13 +131,11 @@ func (c *Repository) GetCalendar(
 		return nil, err
 	}
 
-	reminders, err := c.getDefaultReminders(ctx, []string{calendar.Id})
+	err = c.loadReminders(ctx, []*pb.Calendar{calendar})
 	if err != nil {
 		return nil, err
 	}
 
-	calendar.DefaultReminders = reminders[calendar.Id]
-
 	return calendar, nil
 }
 
@@ -102,11 +143,18 @@ func (c *Repository) ListCalendars(
 	ctx context.Context, pageSize int32, pageToken *pb.PageToken, filter *pb.ListCalendarsFilter,
 ) ([]*pb.Calendar, *pb.PageToken, error) {
 	query := `
-		select c.id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode
+		select c.id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode,
+			http_etag, http_last_modified, time_zone, credentials_encrypted is not null,
+			source_type, caldav_url, caldav_sync_token, caldav_ctag, file_path, sync_interval, next_sync_time,
+			sync_failures, max_ical_size
 		from calendars c
 		where
 			($2::uuid is null or c.id > $2) and
-			($3::timestamptz is null or last_sync_time < $3)
+			($3::timestamptz is null or last_sync_time is null or last_sync_time < $3) and
+			($4::uuid is null or exists (
+				select 1 from calendar_channels cc where cc.calendar_id = c.id and cc.channel_id = $4
+			)) and
+			($5::timestamptz is null or next_sync_time is null or next_sync_time <= $5)
 		order by c.id
 		limit $1
 	`
@@ -116,15 +164,29 @@ func (c *Repository) ListCalendars(
 		lastID = &pageToken.LastId
 	}
 
-	rows, err := c.db.QueryContext(ctx, query, pageSize, lastID, filter.LastSyncTimeBefore.AsTime())
+	var lastSyncTimeBefore *time.Time
+	if filter.GetLastSyncTimeBefore() != nil {
+		t := filter.GetLastSyncTimeBefore().AsTime()
+		lastSyncTimeBefore = &t
+	}
+
+	var channelID *string
+	if filter.GetChannelId() != "" {
+		channelID = &filter.ChannelId
+	}
+
+	var nextSyncTimeBefore *time.Time
+	if filter.GetNextSyncTimeBefore() != nil {
+		t := filter.GetNextSyncTimeBefore().AsTime()
+		nextSyncTimeBefore = &t
+	}
+
+	rows, err := c.db.QueryContext(ctx, query, pageSize, lastID, lastSyncTimeBefore, channelID, nextSyncTimeBefore)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var (
-		calendars   []*pb.Calendar
-		calendarIDs []string
-	)
+	var calendars []*pb.Calendar
 
 	for rows.Next() {
 		c, err := scanCalendar(rows)
@@ -133,22 +195,17 @@ func (c *Repository) ListCalendars(
 		}
 
 		calendars = append(calendars, c)
-		calendarIDs = append(calendarIDs, c.Id)
 	}
 
 	if rows.Err() != nil {
 		return nil, nil, rows.Err()
 	}
 
-	reminders, err := c.getDefaultReminders(ctx, calendarIDs)
+	err = c.loadReminders(ctx, calendars)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for i := range calendars {
-		calendars[i].DefaultReminders = reminders[calendars[i].Id]
-	}
-
 	var nextPageToken *pb.PageToken
 
 	if int32(len(calendars)) == pageSize {
@@ -166,52 +223,217 @@ func (c *Repository) UpdateCalendar(
 	query := `
 		update calendars set
 			name = coalesce($2, name),
-			ical_url = coalesce($3, ical_url),
 			last_sync_time = coalesce($4, last_sync_time),
-			last_sync_hash = coalesce($5, last_sync_hash),
-			sync_error_pb = coalesce($6, sync_error_pb)
+			last_sync_hash = case when $26 then null else coalesce($5, last_sync_hash) end,
+			sync_error_pb = case when $9 then $6 else sync_error_pb end,
+			http_etag = case when $26 then null when $13 then $7 else coalesce($7, http_etag) end,
+			http_last_modified = case when $26 then null when $13 then $8 else coalesce($8, http_last_modified) end,
+			time_zone = coalesce($10, time_zone),
+			credentials_encrypted = case when $11 then $12 else credentials_encrypted end,
+			source_type = case when $13 then $14 else source_type end,
+			ical_url = case when $13 then $3 else ical_url end,
+			caldav_url = case when $13 then $15 else caldav_url end,
+			file_path = case when $13 then $16 else file_path end,
+			inline_data = case when $13 then $17 else inline_data end,
+			caldav_sync_token = case when $13 or $26 then null else coalesce($18, caldav_sync_token) end,
+			caldav_ctag = case when $13 or $26 then null else coalesce($19, caldav_ctag) end,
+			sync_interval = case when $21 then $20 else sync_interval end,
+			next_sync_time = case when $13 or $21 or $26 then null else coalesce($22, next_sync_time) end,
+			sync_failures = coalesce($23, sync_failures),
+			max_ical_size = case when $25 then nullif($24::bigint, 0) else max_ical_size end
 		where id = $1
-		returning id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode
+		returning id, name, ical_url, last_sync_time, last_sync_hash, sync_error_pb, default_reminder_mode,
+			http_etag, http_last_modified, time_zone, credentials_encrypted is not null,
+			source_type, caldav_url, caldav_sync_token, caldav_ctag, file_path, sync_interval, next_sync_time,
+			sync_failures, max_ical_size
 	`
 
 	var (
 		name         sql.Null[string]
-		icalURL      sql.Null[string]
 		lastSyncTime sql.Null[time.Time]
 		lastSyncHash sql.Null[[]byte]
 		syncError    sql.Null[[]byte]
+		setSyncError bool
+		etag         sql.Null[string]
+		lastModified sql.Null[string]
+		timeZone     sql.Null[string]
+
+		setDefaultReminders bool
+		setAllDayReminders  bool
+
+		setCredentials bool
+		credentials    []byte
+
+		// Changing the source resets the versions of the previous CalDAV collection
+		setSource       bool
+		caldavSyncToken sql.Null[string]
+		caldavCTag      sql.Null[string]
+
+		// Changing the sync interval or the source makes the calendar due right away, so the new settings apply
+		// without waiting for the previously scheduled sync
+		setSyncInterval bool
+		nextSyncTime    sql.Null[time.Time]
+		syncFailures    sql.Null[int32]
+
+		// An unset size limit resets the calendar to the configured limit
+		setSizeLimit bool
+
+		// Changing the settings that alarms are calculated with forgets the versions of the feed, so the next sync
+		// imports it even if it didn't change. The calendar is due right away for the same reason.
+		resetSyncState bool
 	)
 
 	for _, p := range mask.GetPaths() {
 		switch p {
 		case "name":
 			name = sql.Null[string]{V: calendar.Name, Valid: true}
-		case "ical_url":
-			icalURL = sql.Null[string]{V: calendar.IcalUrl, Valid: true}
+		case "ical_url", "source", "http", "http.url", "caldav", "caldav.collection_url", "file", "file.path", "inline",
+			"inline.data":
+			setSource = true
 		case "last_sync_time":
 			lastSyncTime = sql.Null[time.Time]{V: calendar.LastSyncTime.AsTime(), Valid: true}
 		case "last_sync_hash":
 			lastSyncHash = sql.Null[[]byte]{V: calendar.LastSyncHash, Valid: true}
 		case "last_sync_error":
+			setSyncError = true
+
+			// An unset error clears the stored one
+			if calendar.LastSyncError == nil {
+				continue
+			}
+
 			syncErrorBytes, err := proto.Marshal(calendar.LastSyncError)
 			if err != nil {
 				return nil, err
 			}
 
 			syncError = sql.Null[[]byte]{V: syncErrorBytes, Valid: true}
+		case "http_etag":
+			etag = sql.Null[string]{V: calendar.HttpEtag, Valid: true}
+		case "http_last_modified":
+			lastModified = sql.Null[string]{V: calendar.HttpLastModified, Valid: true}
+		case "time_zone":
+			err := validateTimeZone(calendar.TimeZone)
+			if err != nil {
+				return nil, err
+			}
+
+			timeZone = sql.Null[string]{V: calendar.TimeZone, Valid: true}
+			resetSyncState = true
+		case "credentials":
+			var err error
+
+			// Unset credentials clear the stored ones
+			setCredentials = true
+
+			credentials, err = c.encryptCredentials(calendar.Id, calendar.Credentials)
+			if err != nil {
+				return nil, err
+			}
+		case "caldav.sync_token":
+			caldavSyncToken = sql.Null[string]{V: calendar.GetCaldav().GetSyncToken(), Valid: true}
+		case "caldav.ctag":
+			caldavCTag = sql.Null[string]{V: calendar.GetCaldav().GetCtag(), Valid: true}
+		case "sync_interval":
+			err := validateSyncInterval(calendar.SyncInterval)
+			if err != nil {
+				return nil, err
+			}
+
+			setSyncInterval = true
+		case "next_sync_time":
+			nextSyncTime = sql.Null[time.Time]{V: calendar.NextSyncTime.AsTime(), Valid: true}
+		case "sync_failures":
+			syncFailures = sql.Null[int32]{V: calendar.SyncFailures, Valid: true}
+		case "max_ical_size":
+			err := validateSizeLimit(calendar.MaxIcalSize, c.maxIcalSize)
+			if err != nil {
+				return nil, err
+			}
+
+			setSizeLimit = true
+		case "default_reminders":
+			setDefaultReminders = true
+			resetSyncState = true
+		case "all_day_reminders":
+			err := validateAllDayReminders(calendar.AllDayReminders)
+			if err != nil {
+				return nil, err
+			}
+
+			setAllDayReminders = true
+			resetSyncState = true
 		}
 	}
 
-	return scanCalendar(c.db.QueryRowContext(
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	source := sourceColumnsOf(calendar)
+
+	updated, err := scanCalendar(tx.QueryRowContext(
 		ctx,
 		query,
 		calendar.Id,
 		name,
-		icalURL,
+		source.icalURL,
 		lastSyncTime,
 		lastSyncHash,
 		syncError,
+		etag,
+		lastModified,
+		setSyncError,
+		timeZone,
+		setCredentials,
+		credentials,
+		setSource,
+		source.sourceType,
+		source.caldavURL,
+		source.filePath,
+		source.inlineData,
+		caldavSyncToken,
+		caldavCTag,
+		syncIntervalColumn(calendar.SyncInterval),
+		setSyncInterval,
+		nextSyncTime,
+		syncFailures,
+		calendar.MaxIcalSize,
+		setSizeLimit,
+		resetSyncState,
 	))
+	if err != nil {
+		return nil, err
+	}
+
+	if setDefaultReminders {
+		err = replaceDefaultReminders(ctx, tx, calendar.Id, calendar.DefaultReminders)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if setAllDayReminders {
+		err = replaceAllDayReminders(ctx, tx, calendar.Id, calendar.AllDayReminders)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.loadReminders(ctx, []*pb.Calendar{updated})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
 func (c *Repository) DeleteCalendar(ctx context.Context, id string) error {
@@ -219,6 +441,100 @@ func (c *Repository) DeleteCalendar(ctx context.Context, id string) error {
 	return err
 }
 
+// GetInlineData returns the iCal data of a calendar with an inline source.
+func (c *Repository) GetInlineData(ctx context.Context, id string) ([]byte, error) {
+	var data []byte
+
+	err := c.db.QueryRowContext(ctx, `select inline_data from calendars where id = $1`, id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// GetCredentials returns the decrypted credentials of the calendar's feed, or nil if it has none.
+func (c *Repository) GetCredentials(ctx context.Context, id string) (*pb.FeedCredentials, error) {
+	var encrypted []byte
+
+	err := c.db.QueryRowContext(ctx, `select credentials_encrypted from calendars where id = $1`, id).
+		Scan(&encrypted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if encrypted == nil {
+		return nil, nil
+	}
+
+	plaintext, err := c.cipher.Decrypt(encrypted, []byte(id))
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := &pb.FeedCredentials{}
+
+	err = proto.Unmarshal(plaintext, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials, nil
+}
+
+// encryptCredentials validates and encrypts the credentials for storage, bound to the calendar's ID. Nil
+// credentials are stored as NULL.
+func (c *Repository) encryptCredentials(id string, credentials *pb.FeedCredentials) ([]byte, error) {
+	if credentials == nil {
+		return nil, nil
+	}
+
+	err := validateCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := proto.Marshal(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cipher.Encrypt(plaintext, []byte(id))
+}
+
+// loadReminders sets the default and all-day reminders of the calendars.
+func (c *Repository) loadReminders(ctx context.Context, calendars []*pb.Calendar) error {
+	calendarIDs := make([]string, 0, len(calendars))
+	for _, calendar := range calendars {
+		calendarIDs = append(calendarIDs, calendar.Id)
+	}
+
+	defaultReminders, err := c.getDefaultReminders(ctx, calendarIDs)
+	if err != nil {
+		return err
+	}
+
+	allDayReminders, err := c.getAllDayReminders(ctx, calendarIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, calendar := range calendars {
+		calendar.DefaultReminders = defaultReminders[calendar.Id]
+		calendar.AllDayReminders = allDayReminders[calendar.Id]
+	}
+
+	return nil
+}
+
 func (c *Repository) getDefaultReminders(
 	ctx context.Context, calendarIDs []string,
 ) (map[string][]*pb.DefaultReminder, error) {
@@ -245,6 +561,188 @@ func (c *Repository) getDefaultReminders(
 	return defaultReminders, nil
 }
 
+func (c *Repository) getAllDayReminders(
+	ctx context.Context, calendarIDs []string,
+) (map[string][]*pb.AllDayReminder, error) {
+	rows, err := c.db.QueryContext(ctx, `
+		select id, calendar_id, days_before, time_of_day
+		from calendar_all_day_reminders
+		where calendar_id = any($1::uuid[])
+		order by days_before desc, time_of_day
+	`, calendarIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	allDayReminders := make(map[string][]*pb.AllDayReminder, len(calendarIDs))
+
+	for rows.Next() {
+		var (
+			allDayReminder = &pb.AllDayReminder{}
+			calendarID     string
+			timeOfDay      pgtype.Interval
+		)
+
+		err := rows.Scan(&allDayReminder.Id, &calendarID, &allDayReminder.DaysBefore, &timeOfDay)
+		if err != nil {
+			return nil, err
+		}
+
+		allDayReminder.TimeOfDay = durationpb.New(pgIntervalToDuration(timeOfDay))
+		allDayReminders[calendarID] = append(allDayReminders[calendarID], allDayReminder)
+	}
+
+	return allDayReminders, rows.Err()
+}
+
+func replaceReminders(
+	ctx context.Context,
+	tx *sql.Tx,
+	calendarID string,
+	defaultReminders []*pb.DefaultReminder,
+	allDayReminders []*pb.AllDayReminder,
+) error {
+	err := replaceDefaultReminders(ctx, tx, calendarID, defaultReminders)
+	if err != nil {
+		return err
+	}
+
+	return replaceAllDayReminders(ctx, tx, calendarID, allDayReminders)
+}
+
+func replaceDefaultReminders(
+	ctx context.Context, tx *sql.Tx, calendarID string, defaultReminders []*pb.DefaultReminder,
+) error {
+	_, err := tx.ExecContext(ctx, `delete from calendar_default_reminders where calendar_id = $1`, calendarID)
+	if err != nil {
+		return err
+	}
+
+	if len(defaultReminders) == 0 {
+		return nil
+	}
+
+	query := sq.Insert("calendar_default_reminders").Columns("id", "calendar_id", "before")
+
+	for _, defaultReminder := range defaultReminders {
+		defaultReminder.Id = uuid.New().String()
+		query = query.Values(
+			defaultReminder.Id, calendarID, durationToPgInterval(defaultReminder.Before.AsDuration()),
+		)
+	}
+
+	return execInsert(ctx, tx, query)
+}
+
+func replaceAllDayReminders(
+	ctx context.Context, tx *sql.Tx, calendarID string, allDayReminders []*pb.AllDayReminder,
+) error {
+	_, err := tx.ExecContext(ctx, `delete from calendar_all_day_reminders where calendar_id = $1`, calendarID)
+	if err != nil {
+		return err
+	}
+
+	if len(allDayReminders) == 0 {
+		return nil
+	}
+
+	query := sq.Insert("calendar_all_day_reminders").Columns("id", "calendar_id", "days_before", "time_of_day")
+
+	for _, allDayReminder := range allDayReminders {
+		allDayReminder.Id = uuid.New().String()
+		query = query.Values(
+			allDayReminder.Id,
+			calendarID,
+			allDayReminder.DaysBefore,
+			durationToPgInterval(allDayReminder.TimeOfDay.AsDuration()),
+		)
+	}
+
+	return execInsert(ctx, tx, query)
+}
+
+func execInsert(ctx context.Context, tx *sql.Tx, insert sq.InsertBuilder) error {
+	query, args, err := insert.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// ValidateSettings checks the time zone, all-day reminders, size limit and credentials of a calendar that isn't
+// stored, like the calendar of a preview.
+func (c *Repository) ValidateSettings(calendar *pb.Calendar) error {
+	err := validateTimeZone(calendar.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	err = validateSizeLimit(calendar.MaxIcalSize, c.maxIcalSize)
+	if err != nil {
+		return err
+	}
+
+	err = validateAllDayReminders(calendar.AllDayReminders)
+	if err != nil {
+		return err
+	}
+
+	if calendar.Credentials == nil {
+		return nil
+	}
+
+	return validateCredentials(calendar.Credentials)
+}
+
+// validateCredentials checks that the credentials can be sent as HTTP headers.
+func validateCredentials(credentials *pb.FeedCredentials) error {
+	values := []string{credentials.GetBearerToken()}
+
+	if basicAuth := credentials.GetBasicAuth(); basicAuth != nil {
+		if strings.Contains(basicAuth.Username, ":") {
+			return fmt.Errorf("%w: username must not contain a colon", ErrInvalidCredentials)
+		}
+	}
+
+	for name, value := range credentials.Headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			return fmt.Errorf("%w: invalid header name %q", ErrInvalidCredentials, name)
+		}
+
+		values = append(values, value)
+	}
+
+	for _, value := range values {
+		if !httpguts.ValidHeaderFieldValue(value) {
+			return fmt.Errorf("%w: invalid header value", ErrInvalidCredentials)
+		}
+	}
+
+	return nil
+}
+
+// validateAllDayReminders checks that all-day reminders are on or before the day of the event, at a time within
+// the day.
+func validateAllDayReminders(allDayReminders []*pb.AllDayReminder) error {
+	for _, allDayReminder := range allDayReminders {
+		if allDayReminder.DaysBefore < 0 {
+			return fmt.Errorf("%w: days_before must not be negative", ErrInvalidReminder)
+		}
+
+		timeOfDay := allDayReminder.TimeOfDay.AsDuration()
+		if timeOfDay < 0 || timeOfDay >= 24*time.Hour {
+			return fmt.Errorf("%w: time_of_day must be within a day", ErrInvalidReminder)
+		}
+	}
+
+	return nil
+}
+
 type scanner interface {
 	Scan(dest ...any) error
 }
@@ -255,6 +753,16 @@ func scanCalendar(sc scanner) (*pb.Calendar, error) {
 		lastSyncTime        sql.Null[time.Time]
 		lastSyncError       sql.Null[[]byte]
 		defaultReminderMode sql.Null[string]
+		etag                sql.Null[string]
+		lastModified        sql.Null[string]
+		sourceType          string
+		caldavURL           sql.Null[string]
+		caldavSyncToken     sql.Null[string]
+		caldavCTag          sql.Null[string]
+		filePath            sql.Null[string]
+		syncInterval        pgtype.Interval
+		nextSyncTime        sql.Null[time.Time]
+		maxIcalSize         sql.Null[int64]
 	)
 
 	err := sc.Scan(
@@ -265,6 +773,19 @@ func scanCalendar(sc scanner) (*pb.Calendar, error) {
 		&calendar.LastSyncHash,
 		&lastSyncError,
 		&defaultReminderMode,
+		&etag,
+		&lastModified,
+		&calendar.TimeZone,
+		&calendar.HasCredentials,
+		&sourceType,
+		&caldavURL,
+		&caldavSyncToken,
+		&caldavCTag,
+		&filePath,
+		&syncInterval,
+		&nextSyncTime,
+		&calendar.SyncFailures,
+		&maxIcalSize,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrNotFound
@@ -288,6 +809,34 @@ func scanCalendar(sc scanner) (*pb.Calendar, error) {
 		calendar.LastSyncError = &status
 	}
 
+	calendar.HttpEtag = etag.V
+	calendar.HttpLastModified = lastModified.V
+
+	if syncInterval.Valid {
+		calendar.SyncInterval = durationpb.New(pgIntervalToDuration(syncInterval))
+	}
+
+	if nextSyncTime.Valid {
+		calendar.NextSyncTime = timestamppb.New(nextSyncTime.V)
+	}
+
+	calendar.MaxIcalSize = maxIcalSize.V
+
+	switch sourceType {
+	case sourceHTTP:
+		calendar.Source = &pb.Calendar_Http{Http: &pb.HTTPSource{Url: calendar.IcalUrl}}
+	case sourceCalDAV:
+		calendar.Source = &pb.Calendar_Caldav{Caldav: &pb.CalDAVSource{
+			CollectionUrl: caldavURL.V,
+			SyncToken:     caldavSyncToken.V,
+			Ctag:          caldavCTag.V,
+		}}
+	case sourceFile:
+		calendar.Source = &pb.Calendar_File{File: &pb.FileSource{Path: filePath.V}}
+	case sourceInline:
+		calendar.Source = &pb.Calendar_Inline{Inline: &pb.InlineSource{}}
+	}
+
 	if defaultReminderMode.Valid {
 		calendar.DefaultReminderMode = pb.DefaultReminderMode(pb.DefaultReminderMode_value[defaultReminderMode.V])
 	}
@@ -295,6 +844,105 @@ func scanCalendar(sc scanner) (*pb.Calendar, error) {
 	return calendar, nil
 }
 
+// Types of calendar sources, stored in the source_type column.
+const (
+	sourceHTTP   = "http"
+	sourceCalDAV = "caldav"
+	sourceFile   = "file"
+	sourceInline = "inline"
+)
+
+// sourceColumns are the stored columns of a calendar's source. Only those of the selected source type are set.
+type sourceColumns struct {
+	sourceType string
+	icalURL    string
+	caldavURL  sql.Null[string]
+	filePath   sql.Null[string]
+	inlineData []byte
+}
+
+// sourceColumnsOf returns the columns of the calendar's source. Calendars without a source use the HTTP source with
+// their ical_url.
+func sourceColumnsOf(calendar *pb.Calendar) sourceColumns {
+	switch source := calendar.Source.(type) {
+	case *pb.Calendar_Http:
+		return sourceColumns{sourceType: sourceHTTP, icalURL: source.Http.GetUrl()}
+	case *pb.Calendar_Caldav:
+		return sourceColumns{
+			sourceType: sourceCalDAV,
+			caldavURL:  sql.Null[string]{V: source.Caldav.GetCollectionUrl(), Valid: true},
+		}
+	case *pb.Calendar_File:
+		return sourceColumns{
+			sourceType: sourceFile,
+			filePath:   sql.Null[string]{V: source.File.GetPath(), Valid: true},
+		}
+	case *pb.Calendar_Inline:
+		// The data is never NULL for inline sources, so it can be told apart from a missing one
+		data := source.Inline.GetData()
+		if data == nil {
+			data = []byte{}
+		}
+
+		return sourceColumns{sourceType: sourceInline, inlineData: data}
+	default:
+		return sourceColumns{sourceType: sourceHTTP, icalURL: calendar.IcalUrl}
+	}
+}
+
+// validateSyncInterval checks that the sync interval is unset, which means the configured default, or at least
+// minSyncInterval.
+func validateSyncInterval(syncInterval *durationpb.Duration) error {
+	if syncInterval == nil {
+		return nil
+	}
+
+	if !syncInterval.IsValid() || syncInterval.AsDuration() < minSyncInterval {
+		return fmt.Errorf("%w: must be at least %s", ErrInvalidSyncInterval, minSyncInterval)
+	}
+
+	return nil
+}
+
+// validateSizeLimit checks that the size limit of the feed is unset, which means the configured limit, or positive
+// and at most maxLimit.
+func validateSizeLimit(maxIcalSize, maxLimit int64) error {
+	if maxIcalSize < 0 {
+		return fmt.Errorf("%w: must not be negative", ErrInvalidSizeLimit)
+	}
+
+	if maxIcalSize > maxLimit {
+		return fmt.Errorf("%w: must be at most %d bytes", ErrInvalidSizeLimit, maxLimit)
+	}
+
+	return nil
+}
+
+// syncIntervalColumn converts the sync interval of a calendar into its column value, which is null for the
+// configured default.
+func syncIntervalColumn(syncInterval *durationpb.Duration) pgtype.Interval {
+	if syncInterval == nil {
+		return pgtype.Interval{}
+	}
+
+	return durationToPgInterval(syncInterval.AsDuration())
+}
+
+// validateTimeZone checks that the time zone is empty, which means UTC, or a known IANA time zone.
+func validateTimeZone(timeZone string) error {
+	if timeZone == "" {
+		return nil
+	}
+
+	// LoadLocation also accepts "Local", which depends on the server's configuration
+	_, err := time.LoadLocation(timeZone)
+	if err != nil || timeZone == "Local" {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeZone, timeZone)
+	}
+
+	return nil
+}
+
 func scanDefaultReminder(sc scanner) (*pb.DefaultReminder, string, error) {
 	var (
 		defaultReminder = &pb.DefaultReminder{}
@@ -318,6 +966,10 @@ func scanDefaultReminder(sc scanner) (*pb.DefaultReminder, string, error) {
 	return defaultReminder, calendarID, nil
 }
 
+func durationToPgInterval(d time.Duration) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Valid: true}
+}
+
 func pgIntervalToDuration(in pgtype.Interval) time.Duration {
-	return time.Duration(in.Microseconds) + time.Duration(in.Days)*24*time.Hour + time.Duration(in.Months)*30*24*time.Hour
+	return time.Duration(in.Microseconds)*time.Microsecond + time.Duration(in.Days)*24*time.Hour + time.Duration(in.Months)*30*24*time.Hour
 }
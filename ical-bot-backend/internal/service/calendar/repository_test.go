@@ -0,0 +1,179 @@
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database/databasetest"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+func TestValidateSizeLimit(t *testing.T) {
+	testcases := []struct {
+		Name        string
+		MaxIcalSize int64
+
+		ExpectedErr error
+	}{{
+		Name:        "Unset",
+		MaxIcalSize: 0,
+	}, {
+		Name:        "Maximum",
+		MaxIcalSize: 1024,
+	}, {
+		Name:        "Negative",
+		MaxIcalSize: -1,
+		ExpectedErr: ErrInvalidSizeLimit,
+	}, {
+		Name:        "Above the maximum",
+		MaxIcalSize: 1025,
+		ExpectedErr: ErrInvalidSizeLimit,
+	}, {
+		Name:        "Largest int64",
+		MaxIcalSize: math.MaxInt64,
+		ExpectedErr: ErrInvalidSizeLimit,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			err := validateSizeLimit(testcase.MaxIcalSize, 1024)
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReplaceReminders(t *testing.T) {
+	testcases := []struct {
+		Name string
+		IDs  []string
+	}{{
+		Name: "New reminders",
+		IDs:  []string{"", ""},
+	}, {
+		Name: "Duplicate IDs",
+		IDs:  []string{"reminder-1", "reminder-1"},
+	}, {
+		Name: "IDs of another calendar",
+		IDs:  []string{uuid.New().String(), uuid.New().String()},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			connector := &databasetest.Connector{}
+			tx, err := sql.OpenDB(connector).BeginTx(context.Background(), nil)
+			require.NoError(t, err)
+
+			defaultReminders := make([]*pb.DefaultReminder, 0, len(testcase.IDs))
+			allDayReminders := make([]*pb.AllDayReminder, 0, len(testcase.IDs))
+
+			for _, id := range testcase.IDs {
+				defaultReminders = append(defaultReminders, &pb.DefaultReminder{
+					Id: id, Before: durationpb.New(time.Hour),
+				})
+				allDayReminders = append(allDayReminders, &pb.AllDayReminder{
+					Id: id, TimeOfDay: durationpb.New(8 * time.Hour),
+				})
+			}
+
+			err = replaceReminders(context.Background(), tx, "calendar-1", defaultReminders, allDayReminders)
+			require.NoError(t, err)
+
+			// The server assigns the IDs, client IDs could collide with each other or with other calendars
+			var ids []any
+			for _, table := range []string{"calendar_default_reminders", "calendar_all_day_reminders"} {
+				inserts := connector.Statements("INSERT INTO " + table)
+				require.Len(t, inserts, 1)
+
+				columns := len(inserts[0].Args) / len(testcase.IDs)
+				for i := 0; i < len(inserts[0].Args); i += columns {
+					ids = append(ids, inserts[0].Args[i])
+				}
+			}
+
+			for _, reminder := range defaultReminders {
+				require.Contains(t, ids, reminder.Id)
+			}
+
+			for _, reminder := range allDayReminders {
+				require.Contains(t, ids, reminder.Id)
+			}
+
+			seen := make(map[any]bool, len(ids))
+			for _, id := range ids {
+				require.NotContains(t, testcase.IDs, id)
+				require.False(t, seen[id])
+
+				seen[id] = true
+			}
+		})
+	}
+}
+
+func TestRepository_UpdateCalendarResetsSyncState(t *testing.T) {
+	testcases := []struct {
+		Name  string
+		Paths []string
+
+		ExpectedReset bool
+	}{{
+		Name:          "Default reminders",
+		Paths:         []string{"default_reminders"},
+		ExpectedReset: true,
+	}, {
+		Name:          "All-day reminders",
+		Paths:         []string{"name", "all_day_reminders"},
+		ExpectedReset: true,
+	}, {
+		Name:          "Time zone",
+		Paths:         []string{"time_zone"},
+		ExpectedReset: true,
+	}, {
+		Name:  "Name",
+		Paths: []string{"name"},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			connector := &databasetest.Connector{Rows: map[string][][]driver.Value{
+				"update calendars set": {{
+					"calendar-1", "Team", "https://example.com/team.ics", nil, []byte("hash"), nil, nil, "etag", nil,
+					"Europe/Berlin", false, sourceHTTP, nil, nil, nil, nil, nil, nil, int64(0), nil,
+				}},
+			}}
+			repo := NewCalendarRepository(sql.OpenDB(connector), nil, 1024)
+
+			_, err := repo.UpdateCalendar(context.Background(), &pb.Calendar{
+				Id:               "calendar-1",
+				Name:             "Team",
+				TimeZone:         "Europe/Berlin",
+				DefaultReminders: []*pb.DefaultReminder{{Before: durationpb.New(time.Hour)}},
+				AllDayReminders:  []*pb.AllDayReminder{{TimeOfDay: durationpb.New(8 * time.Hour)}},
+			}, &fieldmaskpb.FieldMask{Paths: testcase.Paths})
+			require.NoError(t, err)
+
+			// The stored feed versions would make the next import skip the feed, and keep the alarms of the old
+			// settings
+			updates := connector.Statements("update calendars set")
+			require.Len(t, updates, 1)
+			require.Contains(t, updates[0].Query, "last_sync_hash = case when $26 then null")
+			require.Contains(t, updates[0].Query, "http_etag = case when $26 then null")
+			require.Contains(t, updates[0].Query, "http_last_modified = case when $26 then null")
+			require.Equal(t, testcase.ExpectedReset, updates[0].Args[25])
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// multipartOverhead is the room left in multipart requests for the framing around the uploaded iCal data.
+const multipartOverhead = 1024 * 1024
+
+// uploadFormName is the name of the multipart part with the iCal data.
+const uploadFormName = "file"
+
+// RegisterCalendarDataUpload adds the multipart/form-data route of ImportCalendarData to the gateway, which only maps
+// JSON request bodies to gRPC requests on its own. Browsers and curl -F can upload .ics files to it directly.
+// Uploads are limited to maxSize bytes of iCal data.
+func RegisterCalendarDataUpload(mux *runtime.ServeMux, client pb.IcalBotServiceClient, maxSize int64) error {
+	return mux.HandlePath(
+		http.MethodPost, "/v1/calendars/{calendar_id}/data",
+		func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+			ctx, err := runtime.AnnotateContext(
+				r.Context(), mux, r, pb.IcalBotService_ImportCalendarData_FullMethodName,
+				runtime.WithHTTPPathPattern("/v1/calendars/{calendar_id}/data"),
+			)
+			if err != nil {
+				runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			data, err := readUploadedFile(w, r, maxSize)
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			resp, err := client.ImportCalendarData(ctx, &pb.ImportCalendarDataRequest{
+				CalendarId: pathParams["calendar_id"],
+				Data:       data,
+			})
+			if err != nil {
+				runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, resp, mux.GetForwardResponseOptions()...)
+		},
+	)
+}
+
+// readUploadedFile returns the content of the file part of a multipart request. Other parts are skipped.
+func readUploadedFile(w http.ResponseWriter, r *http.Request, maxSize int64) ([]byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+multipartOverhead)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expected a multipart/form-data request")
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, status.Errorf(codes.InvalidArgument, "missing %q part", uploadFormName)
+		}
+
+		if err != nil {
+			return nil, uploadError(err)
+		}
+
+		if part.FormName() != uploadFormName {
+			continue
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, maxSize+1))
+		if err != nil {
+			return nil, uploadError(err)
+		}
+
+		if int64(len(data)) > maxSize {
+			return nil, status.Errorf(codes.InvalidArgument, "upload exceeds %d bytes", maxSize)
+		}
+
+		return data, nil
+	}
+}
+
+func uploadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return status.Errorf(codes.InvalidArgument, "upload exceeds %d bytes", maxBytesErr.Limit)
+	}
+
+	return status.Error(codes.InvalidArgument, "malformed multipart body")
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// testMaxUploadSize is the size limit of uploaded iCal data.
+const testMaxUploadSize = 1024
+
+type fakeImportClient struct {
+	pb.IcalBotServiceClient
+
+	request *pb.ImportCalendarDataRequest
+}
+
+func (f *fakeImportClient) ImportCalendarData(
+	_ context.Context, in *pb.ImportCalendarDataRequest, _ ...grpc.CallOption,
+) (*pb.Calendar, error) {
+	f.request = in
+
+	return &pb.Calendar{Id: in.CalendarId}, nil
+}
+
+func TestRegisterCalendarDataUpload(t *testing.T) {
+	testcases := []struct {
+		Name string
+
+		FormName string
+		Data     []byte
+
+		ExpectedStatus int
+	}{{
+		Name:           "File part",
+		FormName:       "file",
+		Data:           []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"),
+		ExpectedStatus: http.StatusOK,
+	}, {
+		Name:           "Missing file part",
+		FormName:       "attachment",
+		Data:           []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"),
+		ExpectedStatus: http.StatusBadRequest,
+	}, {
+		Name:           "As large as allowed",
+		FormName:       "file",
+		Data:           make([]byte, testMaxUploadSize),
+		ExpectedStatus: http.StatusOK,
+	}, {
+		Name:           "Too large",
+		FormName:       "file",
+		Data:           make([]byte, testMaxUploadSize+1),
+		ExpectedStatus: http.StatusBadRequest,
+	}, {
+		Name:           "Too large for the request limit",
+		FormName:       "file",
+		Data:           make([]byte, testMaxUploadSize+multipartOverhead),
+		ExpectedStatus: http.StatusBadRequest,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			client := &fakeImportClient{}
+			mux := runtime.NewServeMux()
+			require.NoError(t, RegisterCalendarDataUpload(mux, client, testMaxUploadSize))
+
+			var body bytes.Buffer
+
+			form := multipart.NewWriter(&body)
+			part, err := form.CreateFormFile(testcase.FormName, "schedule.ics")
+			require.NoError(t, err)
+			_, err = part.Write(testcase.Data)
+			require.NoError(t, err)
+			require.NoError(t, form.Close())
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/calendars/calendar-1/data", &body)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			require.Equal(t, testcase.ExpectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.ExpectedStatus == http.StatusOK {
+				require.Equal(t, "calendar-1", client.request.CalendarId)
+				require.Equal(t, testcase.Data, client.request.Data)
+			}
+		})
+	}
+}
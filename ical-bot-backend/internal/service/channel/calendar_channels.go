@@ -0,0 +1,163 @@
+package channel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var ErrCalendarNotFound = errors.New("calendar not found")
+
+// ListCalendarChannels returns the channels subscribed to the calendar, paginated by channel id.
+func (r *Repository) ListCalendarChannels(
+	ctx context.Context, calendarID string, pageSize int32, pageToken *pb.PageToken,
+) ([]*pb.Channel, *pb.PageToken, error) {
+	var id string
+
+	err := r.db.QueryRowContext(ctx, `select id from calendars where id = $1`, calendarID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrCalendarNotFound
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var lastID *string
+	if pageToken.LastId != "" {
+		lastID = &pageToken.LastId
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		select c.id, c.type, c.data
+		from calendar_channels cc
+		join channels c on c.id = cc.channel_id
+		where
+			cc.calendar_id = $1 and
+			($3::uuid is null or c.id > $3)
+		order by c.id
+		limit $2
+	`, calendarID, pageSize, lastID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rows.Close()
+
+	var channels []*pb.Channel
+
+	for rows.Next() {
+		channel, err := scanChannel(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, rows.Err()
+	}
+
+	var nextPageToken *pb.PageToken
+
+	if int32(len(channels)) == pageSize {
+		nextPageToken = &pb.PageToken{
+			LastId: channels[len(channels)-1].Id,
+		}
+	}
+
+	return channels, nextPageToken, nil
+}
+
+// CreateCalendarChannel subscribes the channel to the calendar. Subscribing an already subscribed channel again
+// is not an error.
+func (r *Repository) CreateCalendarChannel(ctx context.Context, calendarID, channelID string) (*pb.Channel, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	err = lockCalendar(ctx, tx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := scanChannel(tx.QueryRowContext(ctx, `
+		select id, type, data
+		from channels
+		where id = $1
+		for share
+	`, channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into calendar_channels (calendar_id, channel_id)
+		values ($1, $2)
+		on conflict do nothing
+	`, calendarID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// DeleteCalendarChannel unsubscribes the channel from the calendar. Removing a subscription that doesn't exist is
+// not an error, as long as both the calendar and the channel exist.
+func (r *Repository) DeleteCalendarChannel(ctx context.Context, calendarID, channelID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	err = lockCalendar(ctx, tx, calendarID)
+	if err != nil {
+		return err
+	}
+
+	_, err = scanChannel(tx.QueryRowContext(ctx, `
+		select id, type, data
+		from channels
+		where id = $1
+		for share
+	`, channelID))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		delete from calendar_channels
+		where calendar_id = $1 and channel_id = $2
+	`, calendarID, channelID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// lockCalendar checks that the calendar exists and prevents it from being deleted until the transaction ends.
+func lockCalendar(ctx context.Context, tx *sql.Tx, calendarID string) error {
+	var id string
+
+	err := tx.QueryRowContext(ctx, `select id from calendars where id = $1 for share`, calendarID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrCalendarNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,192 @@
+package channel
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database/databasetest"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const (
+	queryLockCalendar = "select id from calendars where id = $1 for share"
+	queryGetCalendar  = "select id from calendars where id = $1"
+	queryGetChannel   = "from channels where id = $1 for share"
+	queryListChannels = "from calendar_channels cc join channels c"
+)
+
+func telegramChannel(t *testing.T, id string) (*pb.Channel, []driver.Value) {
+	t.Helper()
+
+	channel := &pb.Channel{
+		Id:          id,
+		ChannelType: &pb.Channel_Telegram{Telegram: &pb.TelegramChat{Id: -100123, Type: "group", Name: "Team"}},
+	}
+
+	channelType, data, err := Marshal(channel)
+	require.NoError(t, err)
+
+	return channel, []driver.Value{id, channelType, data}
+}
+
+func TestRepository_CalendarChannels(t *testing.T) {
+	channel, row := telegramChannel(t, "channel-1")
+
+	testcases := []struct {
+		Name string
+
+		Rows map[string][][]driver.Value
+
+		ExpectedErr error
+	}{{
+		Name: "Subscribed",
+		Rows: map[string][][]driver.Value{
+			queryLockCalendar: {{"calendar-1"}},
+			queryGetChannel:   {row},
+		},
+	}, {
+		Name:        "Calendar not found",
+		Rows:        map[string][][]driver.Value{queryGetChannel: {row}},
+		ExpectedErr: ErrCalendarNotFound,
+	}, {
+		Name:        "Channel not found",
+		Rows:        map[string][][]driver.Value{queryLockCalendar: {{"calendar-1"}}},
+		ExpectedErr: ErrNotFound,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			t.Run("Create", func(t *testing.T) {
+				connector := &databasetest.Connector{Rows: testcase.Rows}
+				repo := NewChannelRepository(sql.OpenDB(connector))
+
+				created, err := repo.CreateCalendarChannel(context.Background(), "calendar-1", "channel-1")
+				inserts := connector.Statements("insert into calendar_channels")
+
+				if testcase.ExpectedErr != nil {
+					require.ErrorIs(t, err, testcase.ExpectedErr)
+					require.Empty(t, inserts)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, channel.Id, created.Id)
+				require.Equal(t, channel.GetTelegram().Id, created.GetTelegram().Id)
+				require.Len(t, inserts, 1)
+				require.Equal(t, []any{"calendar-1", "channel-1"}, inserts[0].Args)
+				require.True(t, inserts[0].InTx)
+			})
+
+			t.Run("Delete", func(t *testing.T) {
+				connector := &databasetest.Connector{Rows: testcase.Rows}
+				repo := NewChannelRepository(sql.OpenDB(connector))
+
+				err := repo.DeleteCalendarChannel(context.Background(), "calendar-1", "channel-1")
+				deletes := connector.Statements("delete from calendar_channels")
+
+				if testcase.ExpectedErr != nil {
+					require.ErrorIs(t, err, testcase.ExpectedErr)
+					require.Empty(t, deletes)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.Len(t, deletes, 1)
+				require.Equal(t, []any{"calendar-1", "channel-1"}, deletes[0].Args)
+				require.True(t, deletes[0].InTx)
+			})
+		})
+	}
+}
+
+func TestRepository_ListCalendarChannels(t *testing.T) {
+	_, first := telegramChannel(t, "channel-1")
+	_, second := telegramChannel(t, "channel-2")
+
+	testcases := []struct {
+		Name      string
+		Rows      map[string][][]driver.Value
+		PageSize  int32
+		PageToken *pb.PageToken
+
+		ExpectedErr           error
+		ExpectedIDs           []string
+		ExpectedNextPageToken *pb.PageToken
+		ExpectedLastID        string
+	}{{
+		Name: "Last page",
+		Rows: map[string][][]driver.Value{
+			queryGetCalendar:  {{"calendar-1"}},
+			queryListChannels: {first, second},
+		},
+		PageSize:    50,
+		PageToken:   &pb.PageToken{},
+		ExpectedIDs: []string{"channel-1", "channel-2"},
+	}, {
+		Name: "Full page",
+		Rows: map[string][][]driver.Value{
+			queryGetCalendar:  {{"calendar-1"}},
+			queryListChannels: {second},
+		},
+		PageSize:              1,
+		PageToken:             &pb.PageToken{LastId: "channel-1"},
+		ExpectedIDs:           []string{"channel-2"},
+		ExpectedNextPageToken: &pb.PageToken{LastId: "channel-2"},
+		ExpectedLastID:        "channel-1",
+	}, {
+		Name:        "Calendar not found",
+		Rows:        map[string][][]driver.Value{queryListChannels: {first}},
+		PageSize:    50,
+		PageToken:   &pb.PageToken{},
+		ExpectedErr: ErrCalendarNotFound,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			connector := &databasetest.Connector{Rows: testcase.Rows}
+			repo := NewChannelRepository(sql.OpenDB(connector))
+
+			channels, nextPageToken, err := repo.ListCalendarChannels(
+				context.Background(), "calendar-1", testcase.PageSize, testcase.PageToken,
+			)
+
+			// Listing is read-only, it neither locks the calendar nor needs a transaction
+			require.Empty(t, connector.Statements(queryLockCalendar))
+
+			lookups := connector.Statements(queryGetCalendar)
+			require.Len(t, lookups, 1)
+			require.False(t, lookups[0].InTx)
+
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+				require.Empty(t, connector.Statements(queryListChannels))
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.ExpectedNextPageToken.GetLastId(), nextPageToken.GetLastId())
+
+			ids := make([]string, 0, len(channels))
+			for _, channel := range channels {
+				ids = append(ids, channel.Id)
+			}
+
+			require.Equal(t, testcase.ExpectedIDs, ids)
+
+			queries := connector.Statements(queryListChannels)
+			require.Len(t, queries, 1)
+			require.False(t, queries[0].InTx)
+
+			if testcase.ExpectedLastID != "" {
+				require.Equal(t, &testcase.ExpectedLastID, queries[0].Args[2])
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package channel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const (
+	TypeTelegram = "telegram"
+	TypeMatrix   = "matrix"
+)
+
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrUnknownChannelType = errors.New("unknown channel type")
+	ErrMissingChannelType = errors.New("channel type is required")
+	ErrInvalidFieldMask   = errors.New("invalid field mask")
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewChannelRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CreateChannel(ctx context.Context, channel *pb.Channel) (*pb.Channel, error) {
+	channelType, data, err := Marshal(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanChannel(r.db.QueryRowContext(ctx, `
+		insert into channels (id, type, data)
+		values ($1, $2, $3)
+		returning id, type, data
+	`, uuid.New().String(), channelType, data))
+}
+
+func (r *Repository) GetChannel(ctx context.Context, id string) (*pb.Channel, error) {
+	return scanChannel(r.db.QueryRowContext(ctx, `
+		select id, type, data
+		from channels
+		where id = $1
+	`, id))
+}
+
+func (r *Repository) ListChannels(
+	ctx context.Context, pageSize int32, pageToken *pb.PageToken,
+) ([]*pb.Channel, *pb.PageToken, error) {
+	var lastID *string
+	if pageToken.LastId != "" {
+		lastID = &pageToken.LastId
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		select id, type, data
+		from channels
+		where $2::uuid is null or id > $2
+		order by id
+		limit $1
+	`, pageSize, lastID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rows.Close()
+
+	var channels []*pb.Channel
+
+	for rows.Next() {
+		channel, err := scanChannel(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, rows.Err()
+	}
+
+	var nextPageToken *pb.PageToken
+
+	if int32(len(channels)) == pageSize {
+		nextPageToken = &pb.PageToken{
+			LastId: channels[len(channels)-1].Id,
+		}
+	}
+
+	return channels, nextPageToken, nil
+}
+
+// UpdateChannel applies the fields selected by mask from channel to the stored channel. Paths address either the
+// whole channel type (e.g. "telegram") or a single field of it (e.g. "telegram.name"). An empty mask replaces the
+// stored channel type entirely.
+func (r *Repository) UpdateChannel(
+	ctx context.Context, channel *pb.Channel, mask *fieldmaskpb.FieldMask,
+) (*pb.Channel, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	existing, err := scanChannel(tx.QueryRowContext(ctx, `
+		select id, type, data
+		from channels
+		where id = $1
+		for update
+	`, channel.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyFieldMask(existing, channel, mask)
+	if err != nil {
+		return nil, err
+	}
+
+	channelType, data, err := Marshal(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := scanChannel(tx.QueryRowContext(ctx, `
+		update channels set type = $2, data = $3
+		where id = $1
+		returning id, type, data
+	`, existing.Id, channelType, data))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *Repository) DeleteChannel(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `delete from channels where id = $1`, id)
+	return err
+}
+
+// Marshal converts the channel type into the representation stored in the channels table: the name of the
+// oneof case in the type column and the JSON encoded message in the data column.
+func Marshal(channel *pb.Channel) (string, []byte, error) {
+	message := channelMessage(channel)
+	if message == nil {
+		return "", nil, ErrMissingChannelType
+	}
+
+	data, err := protojson.Marshal(message.Interface())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return typeOf(channel), data, nil
+}
+
+// Unmarshal is the inverse of Marshal.
+func Unmarshal(id, channelType string, data []byte) (*pb.Channel, error) {
+	channel := &pb.Channel{Id: id}
+
+	switch channelType {
+	case TypeTelegram:
+		telegram := &pb.TelegramChat{}
+
+		err := protojson.Unmarshal(data, telegram)
+		if err != nil {
+			return nil, err
+		}
+
+		channel.ChannelType = &pb.Channel_Telegram{Telegram: telegram}
+	case TypeMatrix:
+		matrix := &pb.MatrixChannel{}
+
+		err := protojson.Unmarshal(data, matrix)
+		if err != nil {
+			return nil, err
+		}
+
+		channel.ChannelType = &pb.Channel_Matrix{Matrix: matrix}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChannelType, channelType)
+	}
+
+	return channel, nil
+}
+
+func applyFieldMask(existing, update *pb.Channel, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		if update.ChannelType == nil {
+			return ErrMissingChannelType
+		}
+
+		existing.ChannelType = update.ChannelType
+
+		return nil
+	}
+
+	existingType := typeOf(existing)
+
+	for _, path := range mask.GetPaths() {
+		channelType, field, isField := strings.Cut(path, ".")
+
+		if channelType != TypeTelegram && channelType != TypeMatrix {
+			return fmt.Errorf("%w: unknown path %q", ErrInvalidFieldMask, path)
+		}
+
+		if typeOf(update) != channelType {
+			return fmt.Errorf("%w: path %q requires %s to be set", ErrInvalidFieldMask, path, channelType)
+		}
+
+		if !isField {
+			existing.ChannelType = update.ChannelType
+			existingType = channelType
+
+			continue
+		}
+
+		if existingType != channelType {
+			return fmt.Errorf("%w: cannot update %q on a %s channel", ErrInvalidFieldMask, path, existingType)
+		}
+
+		err := copyField(channelMessage(existing), channelMessage(update), field)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func typeOf(channel *pb.Channel) string {
+	switch channel.ChannelType.(type) {
+	case *pb.Channel_Telegram:
+		return TypeTelegram
+	case *pb.Channel_Matrix:
+		return TypeMatrix
+	default:
+		return ""
+	}
+}
+
+func channelMessage(channel *pb.Channel) protoreflect.Message {
+	switch c := channel.ChannelType.(type) {
+	case *pb.Channel_Telegram:
+		return c.Telegram.ProtoReflect()
+	case *pb.Channel_Matrix:
+		return c.Matrix.ProtoReflect()
+	default:
+		return nil
+	}
+}
+
+func copyField(dst, src protoreflect.Message, name string) error {
+	field := dst.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		return fmt.Errorf("%w: unknown field %q in %s", ErrInvalidFieldMask, name, dst.Descriptor().Name())
+	}
+
+	if src.Has(field) {
+		dst.Set(field, src.Get(field))
+	} else {
+		dst.Clear(field)
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanChannel(sc scanner) (*pb.Channel, error) {
+	var (
+		id          string
+		channelType string
+		data        []byte
+	)
+
+	err := sc.Scan(&id, &channelType, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Unmarshal(id, channelType, data)
+}
@@ -0,0 +1,133 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	testcases := []struct {
+		Name string
+
+		Channel      *pb.Channel
+		ExpectedType string
+	}{{
+		Name: "Telegram",
+		Channel: &pb.Channel{
+			Id: "channel-1",
+			ChannelType: &pb.Channel_Telegram{Telegram: &pb.TelegramChat{
+				Id: -100123, Type: "group", Name: "Team",
+			}},
+		},
+		ExpectedType: TypeTelegram,
+	}, {
+		Name: "Matrix",
+		Channel: &pb.Channel{
+			Id: "channel-1",
+			ChannelType: &pb.Channel_Matrix{Matrix: &pb.MatrixChannel{
+				RoomId: "!room:example.com", Name: "Team",
+			}},
+		},
+		ExpectedType: TypeMatrix,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			channelType, data, err := Marshal(testcase.Channel)
+			require.NoError(t, err)
+			require.Equal(t, testcase.ExpectedType, channelType)
+
+			channel, err := Unmarshal(testcase.Channel.Id, channelType, data)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(testcase.Channel, channel), "got %v", channel)
+		})
+	}
+}
+
+func TestMarshalWithoutType(t *testing.T) {
+	_, _, err := Marshal(&pb.Channel{Id: "channel-1"})
+	require.ErrorIs(t, err, ErrMissingChannelType)
+}
+
+func TestUnmarshalUnknownType(t *testing.T) {
+	_, err := Unmarshal("channel-1", "irc", []byte(`{}`))
+	require.ErrorIs(t, err, ErrUnknownChannelType)
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	telegram := func(id int64, chatType, name string) *pb.Channel {
+		return &pb.Channel{
+			Id:          "channel-1",
+			ChannelType: &pb.Channel_Telegram{Telegram: &pb.TelegramChat{Id: id, Type: chatType, Name: name}},
+		}
+	}
+	matrix := &pb.Channel{
+		Id:          "channel-1",
+		ChannelType: &pb.Channel_Matrix{Matrix: &pb.MatrixChannel{RoomId: "!room:example.com", Name: "Matrix"}},
+	}
+
+	testcases := []struct {
+		Name string
+
+		Existing *pb.Channel
+		Update   *pb.Channel
+		Paths    []string
+
+		Expected    *pb.Channel
+		ExpectedErr error
+	}{{
+		Name:     "Single field",
+		Existing: telegram(1, "group", "Old"),
+		Update:   telegram(0, "", "New"),
+		Paths:    []string{"telegram.name"},
+		Expected: telegram(1, "group", "New"),
+	}, {
+		Name:     "Whole type",
+		Existing: telegram(1, "group", "Old"),
+		Update:   matrix,
+		Paths:    []string{"matrix"},
+		Expected: matrix,
+	}, {
+		Name:     "Empty mask replaces",
+		Existing: telegram(1, "group", "Old"),
+		Update:   telegram(2, "private", "New"),
+		Expected: telegram(2, "private", "New"),
+	}, {
+		Name:        "Field of other type",
+		Existing:    telegram(1, "group", "Old"),
+		Update:      matrix,
+		Paths:       []string{"matrix.name"},
+		ExpectedErr: ErrInvalidFieldMask,
+	}, {
+		Name:        "Unknown field",
+		Existing:    telegram(1, "group", "Old"),
+		Update:      telegram(1, "group", "Old"),
+		Paths:       []string{"telegram.topic"},
+		ExpectedErr: ErrInvalidFieldMask,
+	}, {
+		Name:        "Unknown path",
+		Existing:    telegram(1, "group", "Old"),
+		Update:      telegram(1, "group", "Old"),
+		Paths:       []string{"id"},
+		ExpectedErr: ErrInvalidFieldMask,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			err := applyFieldMask(testcase.Existing, testcase.Update, &fieldmaskpb.FieldMask{Paths: testcase.Paths})
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, proto.Equal(testcase.Expected, testcase.Existing), "got %v", testcase.Existing)
+		})
+	}
+}
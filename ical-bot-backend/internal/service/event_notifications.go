@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/notification"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var errStreamClosed = errors.New("notification stream closed by client")
+
+// NotificationRepository leases the deliveries of due alarms to streams and records their outcome.
+type NotificationRepository interface {
+	LeaseDue(ctx context.Context, now time.Time, opts notification.LeaseOptions) ([]*pb.EventNotification, error)
+	Acknowledge(
+		ctx context.Context, alarmID string, channelIDs []string, failures []*pb.DeliveryFailure, maxAttempts int32,
+	) error
+	Release(ctx context.Context, deliveries map[string][]string) error
+}
+
+// inFlight tracks the notifications sent on a single stream that have not been acknowledged yet, together with
+// the channels they were sent for.
+type inFlight struct {
+	mu         sync.Mutex
+	channelIDs map[string][]string
+}
+
+func (f *inFlight) add(n *pb.EventNotification) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	channelIDs := make([]string, 0, len(n.Channels))
+	for _, channel := range n.Channels {
+		channelIDs = append(channelIDs, channel.Id)
+	}
+
+	f.channelIDs[n.Id] = channelIDs
+}
+
+func (f *inFlight) remove(id string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	channelIDs := f.channelIDs[id]
+	delete(f.channelIDs, id)
+
+	return channelIDs
+}
+
+func (f *inFlight) all() map[string][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return maps.Clone(f.channelIDs)
+}
+
+// StreamEventNotifications sends due alarms to the connected bot. Every notification is delivered at least once to
+// each channel: a delivery is only marked as delivered when the bot acknowledges it, and it is sent again once the
+// acknowledgement timeout expires, the stream is closed without an acknowledgement, or the bot reports a failure.
+func (b *ICalBackend) StreamEventNotifications(stream pb.IcalBotService_StreamEventNotificationsServer) error {
+	pending := &inFlight{channelIDs: make(map[string][]string)}
+
+	ctx, cancel := context.WithCancelCause(stream.Context())
+	defer cancel(nil)
+
+	// Recv can't be interrupted, it only returns once the client closes the stream or the handler returned. So the
+	// handler doesn't wait for the receiver, the first error of either side ends the stream.
+	go func() {
+		cancel(b.receiveAcknowledgements(ctx, stream, pending))
+	}()
+
+	cancel(b.sendNotifications(ctx, stream, pending))
+
+	err := context.Cause(ctx)
+
+	unacknowledged := pending.all()
+	if len(unacknowledged) != 0 {
+		releaseErr := b.notificationRepo.Release(context.WithoutCancel(ctx), unacknowledged)
+		if releaseErr != nil {
+			b.logger.ErrorContext(ctx, "failed to release unacknowledged notifications", log.Error(releaseErr))
+		}
+	}
+
+	if errors.Is(err, errStreamClosed) {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return err
+}
+
+func (b *ICalBackend) receiveAcknowledgements(
+	ctx context.Context, stream pb.IcalBotService_StreamEventNotificationsServer, pending *inFlight,
+) error {
+	for {
+		ack, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return errStreamClosed
+		}
+
+		if err != nil {
+			return err
+		}
+
+		channelIDs := pending.remove(ack.Id)
+		if channelIDs == nil {
+			b.logger.WarnContext(ctx, "acknowledgement for unknown notification", slog.String("notification_id", ack.Id))
+
+			continue
+		}
+
+		err = b.notificationRepo.Acknowledge(ctx, ack.Id, channelIDs, ack.Failures, b.notificationCfg.MaxAttempts)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (b *ICalBackend) sendNotifications(
+	ctx context.Context, stream pb.IcalBotService_StreamEventNotificationsServer, pending *inFlight,
+) error {
+	ticker := time.NewTicker(b.notificationCfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		notifications, err := b.notificationRepo.LeaseDue(ctx, time.Now(), notification.LeaseOptions{
+			Duration:    b.notificationCfg.AckTimeout,
+			MaxDelay:    b.notificationCfg.MaxDelay,
+			MaxAttempts: b.notificationCfg.MaxAttempts,
+			Limit:       b.notificationCfg.BatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		leasedDeliveries := 0
+
+		for _, n := range notifications {
+			pending.add(n)
+
+			err := stream.Send(n)
+			if err != nil {
+				return err
+			}
+
+			leasedDeliveries += len(n.Channels)
+
+			b.logger.DebugContext(ctx, "sent event notification", slog.String("notification_id", n.Id))
+		}
+
+		// A full batch means there are probably more notifications due, so don't wait for the next tick
+		if int32(leasedDeliveries) >= b.notificationCfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
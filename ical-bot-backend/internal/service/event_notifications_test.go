@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/notification"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// fakeDelivery is the delivery of an alarm to its only channel.
+type fakeDelivery struct {
+	state       string
+	leaseExpiry time.Time
+	attempts    int32
+}
+
+// fakeNotificationRepository leases deliveries like the database does: pending deliveries and those whose lease
+// expired are leased again.
+type fakeNotificationRepository struct {
+	mu         sync.Mutex
+	deliveries map[string]*fakeDelivery
+	leaseErr   error
+}
+
+func newFakeNotificationRepository(alarmIDs ...string) *fakeNotificationRepository {
+	deliveries := make(map[string]*fakeDelivery, len(alarmIDs))
+	for _, alarmID := range alarmIDs {
+		deliveries[alarmID] = &fakeDelivery{state: notification.StatePending}
+	}
+
+	return &fakeNotificationRepository{deliveries: deliveries}
+}
+
+func (f *fakeNotificationRepository) LeaseDue(
+	_ context.Context, now time.Time, opts notification.LeaseOptions,
+) ([]*pb.EventNotification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.leaseErr != nil {
+		return nil, f.leaseErr
+	}
+
+	var notifications []*pb.EventNotification
+
+	for alarmID, delivery := range f.deliveries {
+		expired := delivery.state == notification.StateInFlight && !delivery.leaseExpiry.After(now)
+		if delivery.state != notification.StatePending && !expired {
+			continue
+		}
+
+		delivery.state = notification.StateInFlight
+		delivery.leaseExpiry = now.Add(opts.Duration)
+		delivery.attempts++
+
+		notifications = append(notifications, &pb.EventNotification{
+			Id:       alarmID,
+			Event:    &pb.Event{Id: "event-1"},
+			Channels: []*pb.Channel{{Id: "channel-1"}},
+		})
+	}
+
+	return notifications, nil
+}
+
+func (f *fakeNotificationRepository) Acknowledge(
+	_ context.Context, alarmID string, _ []string, failures []*pb.DeliveryFailure, _ int32,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(failures) != 0 {
+		f.deliveries[alarmID].state = notification.StatePending
+	} else {
+		f.deliveries[alarmID].state = notification.StateDelivered
+	}
+
+	return nil
+}
+
+func (f *fakeNotificationRepository) Release(_ context.Context, deliveries map[string][]string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for alarmID := range deliveries {
+		if f.deliveries[alarmID].state == notification.StateInFlight {
+			f.deliveries[alarmID].state = notification.StatePending
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeNotificationRepository) delivery(alarmID string) fakeDelivery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return *f.deliveries[alarmID]
+}
+
+// fakeNotificationStream is the server side of a notification stream. Like the stream of gRPC, Recv doesn't return
+// when the context is cancelled, only once the client closes its side.
+type fakeNotificationStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent chan *pb.EventNotification
+	acks chan *pb.EventNotificationAcknowledge
+	once sync.Once
+}
+
+func newFakeNotificationStream(ctx context.Context, t *testing.T) *fakeNotificationStream {
+	stream := &fakeNotificationStream{
+		ctx:  ctx,
+		sent: make(chan *pb.EventNotification, 100),
+		acks: make(chan *pb.EventNotificationAcknowledge),
+	}
+	t.Cleanup(stream.closeSend)
+
+	return stream
+}
+
+func (f *fakeNotificationStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeNotificationStream) Send(n *pb.EventNotification) error {
+	f.sent <- n
+
+	return nil
+}
+
+func (f *fakeNotificationStream) Recv() (*pb.EventNotificationAcknowledge, error) {
+	ack, ok := <-f.acks
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return ack, nil
+}
+
+func (f *fakeNotificationStream) closeSend() {
+	f.once.Do(func() {
+		close(f.acks)
+	})
+}
+
+func (f *fakeNotificationStream) next(t *testing.T) *pb.EventNotification {
+	t.Helper()
+
+	select {
+	case n := <-f.sent:
+		return n
+	case <-time.After(5 * time.Second):
+		t.Fatal("no notification was sent")
+
+		return nil
+	}
+}
+
+func testNotificationBackend(repo NotificationRepository, ackTimeout time.Duration) *ICalBackend {
+	return NewICalBackend(nil, nil, repo, nil, nil, config.Notifications{
+		PollInterval: 10 * time.Millisecond,
+		AckTimeout:   ackTimeout,
+		BatchSize:    100,
+		MaxAttempts:  5,
+		MaxDelay:     time.Hour,
+	}, slog.New(slog.DiscardHandler))
+}
+
+// serve runs the stream handler until it returns.
+func serve(backend *ICalBackend, stream *fakeNotificationStream) <-chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- backend.StreamEventNotifications(stream)
+	}()
+
+	return done
+}
+
+func waitForHandler(t *testing.T, done <-chan error) error {
+	t.Helper()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream handler didn't return")
+
+		return nil
+	}
+}
+
+func TestStreamEventNotifications_Acknowledge(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Failures []*pb.DeliveryFailure
+
+		ExpectedState string
+	}{{
+		Name:          "Delivered",
+		ExpectedState: notification.StateDelivered,
+	}, {
+		Name:          "Failed delivery",
+		Failures:      []*pb.DeliveryFailure{{ChannelId: "channel-1", Error: "chat not found"}},
+		ExpectedState: notification.StateInFlight,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			repo := newFakeNotificationRepository("alarm-1")
+			stream := newFakeNotificationStream(context.Background(), t)
+			done := serve(testNotificationBackend(repo, time.Hour), stream)
+
+			n := stream.next(t)
+			require.Equal(t, "alarm-1", n.Id)
+			require.Equal(t, "channel-1", n.Channels[0].Id)
+
+			stream.acks <- &pb.EventNotificationAcknowledge{Id: n.Id, Failures: testcase.Failures}
+
+			if testcase.Failures != nil {
+				// Failed deliveries are retried right away
+				require.Equal(t, "alarm-1", stream.next(t).Id)
+			}
+
+			require.Eventually(t, func() bool {
+				return repo.delivery("alarm-1").state == testcase.ExpectedState
+			}, 5*time.Second, 10*time.Millisecond)
+
+			stream.closeSend()
+			require.NoError(t, waitForHandler(t, done))
+		})
+	}
+}
+
+func TestStreamEventNotifications_RedeliversAfterAckTimeout(t *testing.T) {
+	repo := newFakeNotificationRepository("alarm-1")
+	stream := newFakeNotificationStream(context.Background(), t)
+	done := serve(testNotificationBackend(repo, 50*time.Millisecond), stream)
+
+	require.Equal(t, "alarm-1", stream.next(t).Id)
+	require.Equal(t, "alarm-1", stream.next(t).Id)
+	require.EqualValues(t, 2, repo.delivery("alarm-1").attempts)
+
+	stream.closeSend()
+	require.NoError(t, waitForHandler(t, done))
+}
+
+func TestStreamEventNotifications_RedeliversOnReconnect(t *testing.T) {
+	repo := newFakeNotificationRepository("alarm-1")
+	backend := testNotificationBackend(repo, time.Hour)
+
+	first := newFakeNotificationStream(context.Background(), t)
+	done := serve(backend, first)
+
+	require.Equal(t, "alarm-1", first.next(t).Id)
+
+	first.closeSend()
+	require.NoError(t, waitForHandler(t, done))
+	require.Equal(t, notification.StatePending, repo.delivery("alarm-1").state)
+
+	second := newFakeNotificationStream(context.Background(), t)
+	done = serve(backend, second)
+
+	require.Equal(t, "alarm-1", second.next(t).Id)
+
+	second.closeSend()
+	require.NoError(t, waitForHandler(t, done))
+}
+
+func TestStreamEventNotifications_ReturnsWhileReceiving(t *testing.T) {
+	errLease := errors.New("database is gone")
+
+	testcases := []struct {
+		Name string
+
+		LeaseErr error
+		Cancel   bool
+
+		ExpectedErr  error
+		ExpectedCode codes.Code
+	}{{
+		Name:        "Leasing fails",
+		LeaseErr:    errLease,
+		ExpectedErr: errLease,
+	}, {
+		Name:         "Stream context cancelled",
+		Cancel:       true,
+		ExpectedCode: codes.Canceled,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			repo := newFakeNotificationRepository("alarm-1")
+			repo.leaseErr = testcase.LeaseErr
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stream := newFakeNotificationStream(ctx, t)
+			done := serve(testNotificationBackend(repo, time.Hour), stream)
+
+			if testcase.Cancel {
+				require.Equal(t, "alarm-1", stream.next(t).Id)
+				cancel()
+			}
+
+			// The client never closes its side, so Recv is still blocked when the handler returns
+			err := waitForHandler(t, done)
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+			} else {
+				require.Equal(t, testcase.ExpectedCode, status.Code(err))
+			}
+
+			if testcase.Cancel {
+				require.Equal(t, notification.StatePending, repo.delivery("alarm-1").state)
+			}
+		})
+	}
+}
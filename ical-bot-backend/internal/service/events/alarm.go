@@ -0,0 +1,183 @@
+package events
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// maxAlarmRepetitions limits the REPEAT of a single VALARM, so a broken feed can't create an unbounded number of
+// alarms per occurrence.
+const maxAlarmRepetitions = 100
+
+// alarmTrigger is the time at which an alarm fires for an occurrence of its event.
+type alarmTrigger struct {
+	// offset is relative to the start of the occurrence, or to its end if relatedEnd is set. Negative offsets fire
+	// before it.
+	offset     time.Duration
+	relatedEnd bool
+
+	// absolute is set for triggers at a fixed time, which only apply to the first occurrence of the event
+	absolute time.Time
+
+	// wallClock is set for the reminders of all-day events, which fire at timeOfDay, daysBefore the day of the
+	// occurrence
+	wallClock  bool
+	daysBefore int
+	timeOfDay  time.Duration
+}
+
+func (t alarmTrigger) at(start, end time.Time) time.Time {
+	switch {
+	case !t.absolute.IsZero():
+		return t.absolute
+	case t.wallClock:
+		// The start of an all-day occurrence is midnight in the calendar's time zone. Normalizing the time of day
+		// as part of the date keeps it at the same wall-clock time on days with a DST change.
+		year, month, day := start.Date()
+
+		return time.Date(year, month, day-t.daysBefore, 0, 0, 0, int(t.timeOfDay), start.Location())
+	case t.relatedEnd:
+		return end.Add(t.offset)
+	default:
+		return start.Add(t.offset)
+	}
+}
+
+// span returns the earliest and the latest offset from the start of an occurrence at which the trigger fires, for
+// occurrences that last duration. Absolute triggers only apply to the first occurrence, which starts at eventStart.
+func (t alarmTrigger) span(eventStart time.Time, duration time.Duration) (time.Duration, time.Duration) {
+	switch {
+	case !t.absolute.IsZero():
+		offset := t.absolute.Sub(eventStart)
+
+		return offset, offset
+	case t.wallClock:
+		// Days with a DST change are an hour shorter or longer
+		offset := t.timeOfDay - time.Duration(t.daysBefore)*24*time.Hour
+
+		return offset - time.Hour, offset + time.Hour
+	case t.relatedEnd:
+		return duration + t.offset, duration + t.offset
+	default:
+		return t.offset, t.offset
+	}
+}
+
+// defaultTriggers returns the triggers of the calendar's default reminders. All-day events use the all-day
+// reminders instead, if the calendar has any.
+func defaultTriggers(calendar *pb.Calendar, allDay bool) []alarmTrigger {
+	if allDay && len(calendar.AllDayReminders) > 0 {
+		triggers := make([]alarmTrigger, 0, len(calendar.AllDayReminders))
+
+		for _, allDayReminder := range calendar.AllDayReminders {
+			triggers = append(triggers, alarmTrigger{
+				wallClock:  true,
+				daysBefore: int(allDayReminder.DaysBefore),
+				timeOfDay:  allDayReminder.TimeOfDay.AsDuration(),
+			})
+		}
+
+		return triggers
+	}
+
+	triggers := make([]alarmTrigger, 0, len(calendar.DefaultReminders))
+
+	for _, defaultReminder := range calendar.DefaultReminders {
+		triggers = append(triggers, alarmTrigger{offset: -defaultReminder.Before.AsDuration()})
+	}
+
+	return triggers
+}
+
+// eventTriggers returns the triggers of the VALARM components of the event, including their repetitions. Alarms
+// with one of the ignored actions are skipped, as are alarms that can't be parsed, so a single broken alarm doesn't
+// fail the whole feed.
+func eventTriggers(event *ical.Event, zones *timezone.Zones, ignoredActions []string) []alarmTrigger {
+	var triggers []alarmTrigger
+
+	for _, component := range event.Children {
+		if component.Name != ical.CompAlarm {
+			continue
+		}
+
+		action, err := component.Props.Text(ical.PropAction)
+		if err != nil || slices.ContainsFunc(ignoredActions, func(ignored string) bool {
+			return strings.EqualFold(ignored, action)
+		}) {
+			continue
+		}
+
+		trigger, ok := parseTrigger(component.Props.Get(ical.PropTrigger), zones)
+		if !ok {
+			continue
+		}
+
+		triggers = append(triggers, trigger)
+
+		repeat, interval := alarmRepetition(component)
+		for i := 1; i <= repeat; i++ {
+			repetition := trigger
+			if repetition.absolute.IsZero() {
+				repetition.offset += time.Duration(i) * interval
+			} else {
+				repetition.absolute = repetition.absolute.Add(time.Duration(i) * interval)
+			}
+
+			triggers = append(triggers, repetition)
+		}
+	}
+
+	return triggers
+}
+
+func parseTrigger(prop *ical.Prop, zones *timezone.Zones) (alarmTrigger, bool) {
+	if prop == nil {
+		return alarmTrigger{}, false
+	}
+
+	if prop.ValueType() == ical.ValueDateTime {
+		absolute, err := zones.DateTime(prop)
+		if err != nil {
+			return alarmTrigger{}, false
+		}
+
+		return alarmTrigger{absolute: absolute}, true
+	}
+
+	offset, err := prop.Duration()
+	if err != nil {
+		return alarmTrigger{}, false
+	}
+
+	return alarmTrigger{
+		offset:     offset,
+		relatedEnd: strings.EqualFold(prop.Params.Get(ical.ParamRelated), "END"),
+	}, true
+}
+
+// alarmRepetition returns how often the alarm repeats after it first fires, and the time between repetitions. Both
+// REPEAT and DURATION must be given, otherwise the alarm fires once.
+func alarmRepetition(alarm *ical.Component) (int, time.Duration) {
+	repeatProp, durationProp := alarm.Props.Get(ical.PropRepeat), alarm.Props.Get(ical.PropDuration)
+	if repeatProp == nil || durationProp == nil {
+		return 0, 0
+	}
+
+	repeat, err := repeatProp.Int()
+	if err != nil || repeat <= 0 {
+		return 0, 0
+	}
+
+	interval, err := durationProp.Duration()
+	if err != nil || interval <= 0 {
+		return 0, 0
+	}
+
+	return min(repeat, maxAlarmRepetitions), interval
+}
@@ -0,0 +1,293 @@
+package events
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// decodeEvent decodes a feed with a single event with the given content lines, which include its VALARM components.
+func decodeEvent(t *testing.T, event string) (*ical.Calendar, *ical.Event) {
+	t.Helper()
+
+	data := "BEGIN:VCALENDAR\nPRODID:test\nVERSION:2.0\nBEGIN:VEVENT\nUID:event@example.com\n" +
+		"DTSTAMP:20250101T000000Z\n" + event + "END:VEVENT\nEND:VCALENDAR\n"
+
+	cal, err := ical.NewDecoder(strings.NewReader(strings.ReplaceAll(data, "\n", "\r\n"))).Decode()
+	require.NoError(t, err)
+
+	events := cal.Events()
+	require.Len(t, events, 1)
+
+	return cal, &events[0]
+}
+
+func TestCalculateNextAlarms_Triggers(t *testing.T) {
+	calendar := &pb.Calendar{
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_UNSET_ONLY,
+	}
+
+	window := timerange{
+		from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		Name string
+
+		Event string
+
+		ExpectedAlarmTimes []time.Time
+	}{{
+		Name: "Relative to the start",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT15M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 10, 8, 45, 0, 0, time.UTC)},
+	}, {
+		Name: "Relative to the end",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;RELATED=END:-PT5M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 10, 9, 55, 0, 0, time.UTC)},
+	}, {
+		Name: "Absolute",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;VALUE=DATE-TIME:20250309T180000Z
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 9, 18, 0, 0, 0, time.UTC)},
+	}, {
+		Name: "Absolute only applies to the first occurrence",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+RRULE:FREQ=DAILY;COUNT=3
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;VALUE=DATE-TIME:20250309T180000Z
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 9, 18, 0, 0, 0, time.UTC)},
+	}, {
+		Name: "Repetitions",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT30M
+REPEAT:2
+DURATION:PT10M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{
+			time.Date(2025, 3, 10, 8, 30, 0, 0, time.UTC),
+			time.Date(2025, 3, 10, 8, 40, 0, 0, time.UTC),
+			time.Date(2025, 3, 10, 8, 50, 0, 0, time.UTC),
+		},
+	}, {
+		Name: "Ignored action",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:EMAIL
+TRIGGER:-PT1H
+END:VALARM
+BEGIN:VALARM
+ACTION:AUDIO
+TRIGGER:-PT10M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 10, 8, 50, 0, 0, time.UTC)},
+	}, {
+		Name: "Invalid trigger",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:soon
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{},
+	}, {
+		Name: "Occurrence in the window, alarm before it",
+		Event: `DTSTART:20250301T000500Z
+DTEND:20250301T010000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT15M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{},
+	}, {
+		Name: "Occurrence after the window, alarm in it",
+		Event: `DTSTART:20250331T001000Z
+DTEND:20250331T010000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT15M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 30, 23, 55, 0, 0, time.UTC)},
+	}, {
+		Name: "Occurrence before the window, alarm relative to its end in it",
+		Event: `DTSTART:20250228T230000Z
+DTEND:20250301T010000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;RELATED=END:-PT15M
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 1, 0, 45, 0, 0, time.UTC)},
+	}, {
+		Name: "Occurrence in the window, absolute alarm before it",
+		Event: `DTSTART:20250310T090000Z
+DTEND:20250310T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;VALUE=DATE-TIME:20250228T180000Z
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{},
+	}, {
+		Name: "Occurrence after the window, absolute alarm in it",
+		Event: `DTSTART:20250401T090000Z
+DTEND:20250401T100000Z
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER;VALUE=DATE-TIME:20250330T090000Z
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 30, 9, 0, 0, 0, time.UTC)},
+	}, {
+		Name: "Series reaching past the window",
+		Event: `DTSTART:20250329T090000Z
+DTEND:20250329T100000Z
+RRULE:FREQ=DAILY;COUNT=5
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-P2D
+END:VALARM
+`,
+		ExpectedAlarmTimes: []time.Time{
+			time.Date(2025, 3, 27, 9, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 28, 9, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 29, 9, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 30, 9, 0, 0, 0, time.UTC),
+		},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			cal, event := decodeEvent(t, testcase.Event)
+
+			feed, err := newFeed(cal, time.UTC)
+			require.NoError(t, err)
+
+			alarms, err := calculateNextAlarms(calendar, "event-1", event, feed, []string{"email"}, window)
+			require.NoError(t, err)
+
+			alarmTimes := make([]time.Time, 0, len(alarms))
+			for _, alarm := range alarms {
+				alarmTimes = append(alarmTimes, alarm.AlarmTime.UTC())
+			}
+
+			require.Equal(t, testcase.ExpectedAlarmTimes, alarmTimes)
+		})
+	}
+}
+
+func TestCalculateNextAlarms_AllDay(t *testing.T) {
+	calendar := &pb.Calendar{
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+		DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(15 * time.Minute)}},
+		AllDayReminders: []*pb.AllDayReminder{
+			{DaysBefore: 1, TimeOfDay: durationpb.New(18 * time.Hour)},
+			{TimeOfDay: durationpb.New(8 * time.Hour)},
+		},
+		TimeZone: "Europe/Berlin",
+	}
+
+	window := timerange{
+		from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		Name string
+
+		Event    string
+		Calendar *pb.Calendar
+
+		ExpectedAlarmTimes []time.Time
+	}{{
+		Name:  "All-day event on the day of the DST change",
+		Event: "DTSTART;VALUE=DATE:20250330\n",
+		ExpectedAlarmTimes: []time.Time{
+			time.Date(2025, 3, 29, 17, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 30, 6, 0, 0, 0, time.UTC),
+		},
+	}, {
+		Name:               "Timed event",
+		Event:              "DTSTART:20250330T090000Z\n",
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 30, 8, 45, 0, 0, time.UTC)},
+	}, {
+		Name:  "All-day event after the window, reminder the day before in it",
+		Event: "DTSTART;VALUE=DATE:20250331\n",
+		ExpectedAlarmTimes: []time.Time{
+			time.Date(2025, 3, 30, 16, 0, 0, 0, time.UTC),
+		},
+	}, {
+		Name:  "All-day event without all-day reminders",
+		Event: "DTSTART;VALUE=DATE:20250330\n",
+		Calendar: &pb.Calendar{
+			DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+			DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(15 * time.Minute)}},
+			TimeZone:            "Europe/Berlin",
+		},
+		ExpectedAlarmTimes: []time.Time{time.Date(2025, 3, 29, 22, 45, 0, 0, time.UTC)},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			cal := calendar
+			if testcase.Calendar != nil {
+				cal = testcase.Calendar
+			}
+
+			icalCalendar, event := decodeEvent(t, testcase.Event)
+
+			floating, err := floatingLocation(cal)
+			require.NoError(t, err)
+
+			feed, err := newFeed(icalCalendar, floating)
+			require.NoError(t, err)
+
+			alarms, err := calculateNextAlarms(cal, "event-1", event, feed, nil, window)
+			require.NoError(t, err)
+
+			alarmTimes := make([]time.Time, 0, len(alarms))
+			for _, alarm := range alarms {
+				alarmTimes = append(alarmTimes, alarm.AlarmTime.UTC())
+			}
+
+			require.Equal(t, testcase.ExpectedAlarmTimes, alarmTimes)
+		})
+	}
+}
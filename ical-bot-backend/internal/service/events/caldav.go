@@ -0,0 +1,583 @@
+package events
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// caldavLookbehind is how far back events are fetched from a CalDAV collection. Events that ended before can't have
+// future alarms, but events that are in progress are kept.
+const caldavLookbehind = 24 * time.Hour
+
+// maxSyncRequests limits the sync-collection REPORTs of a single sync, servers truncate large sets of changes and
+// return the rest on the next request.
+const maxSyncRequests = 100
+
+var (
+	ErrInvalidMultistatus = errors.New("invalid WebDAV multistatus response")
+	errSyncTruncated      = errors.New("sync-collection changes truncated too often")
+)
+
+const (
+	propfindCollectionState = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:" xmlns:cs="http://calendarserver.org/ns/">
+  <d:prop>
+    <d:sync-token/>
+    <cs:getctag/>
+  </d:prop>
+</d:propfind>`
+
+	reportCalendarQuery = `<?xml version="1.0" encoding="utf-8"?>
+<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop>
+    <c:calendar-data/>
+  </d:prop>
+  <c:filter>
+    <c:comp-filter name="VCALENDAR">
+      <c:comp-filter name="VEVENT">
+        <c:time-range start="%s"/>
+      </c:comp-filter>
+    </c:comp-filter>
+  </c:filter>
+</c:calendar-query>`
+
+	reportSyncCollection = `<?xml version="1.0" encoding="utf-8"?>
+<d:sync-collection xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:sync-token>%s</d:sync-token>
+  <d:sync-level>1</d:sync-level>
+  <d:prop>
+    <d:getetag/>
+    <c:calendar-data/>
+  </d:prop>
+</d:sync-collection>`
+
+	reportCalendarMultiget = `<?xml version="1.0" encoding="utf-8"?>
+<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+  <d:prop>
+    <c:calendar-data/>
+  </d:prop>
+%s</c:calendar-multiget>`
+)
+
+type multistatus struct {
+	// SyncToken is the version of the collection that a sync-collection REPORT returned the changes up to
+	SyncToken string `xml:"DAV: sync-token"`
+	Responses []struct {
+		Href string `xml:"DAV: href"`
+		// Status is set for members without properties, like the members that a sync-collection REPORT reports as
+		// removed
+		Status    string `xml:"DAV: status"`
+		Propstats []struct {
+			Prop struct {
+				SyncToken    string `xml:"DAV: sync-token"`
+				CTag         string `xml:"http://calendarserver.org/ns/ getctag"`
+				CalendarData string `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+			} `xml:"DAV: prop"`
+			Status string `xml:"DAV: status"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// calendarObject is an iCal file with the components of one event, like a calendar object resource of a CalDAV
+// collection. The href identifies it within its collection.
+type calendarObject struct {
+	href string
+	data string
+}
+
+// CalDAVChanges are changes to the stored calendar objects of a CalDAV collection.
+type CalDAVChanges struct {
+	// Changed has the data of the added and changed objects by their href
+	Changed map[string]string
+	Removed []string
+}
+
+func (c CalDAVChanges) empty() bool {
+	return len(c.Changed) == 0 && len(c.Removed) == 0
+}
+
+// CalDAVObjectStore returns the calendar objects of CalDAV collections that were stored on the last sync, as data
+// by href. The changes of the next sync are applied to them.
+type CalDAVObjectStore interface {
+	GetCalDAVObjects(ctx context.Context, calendarID string) (map[string]string, error)
+}
+
+// caldavClient fetches the events of a calendar collection from a CalDAV server (RFC 4791).
+type caldavClient struct {
+	httpClient  *http.Client
+	credentials *pb.FeedCredentials
+	// maxSize limits the size of responses
+	maxSize int64
+}
+
+// collectionState returns the sync-token (RFC 6578) and the CalendarServer getctag of the collection, both of which
+// change whenever one of its resources does. Servers may support only one of them.
+func (c *caldavClient) collectionState(ctx context.Context, collection *url.URL) (string, string, error) {
+	result, err := c.do(ctx, "PROPFIND", collection, "0", propfindCollectionState)
+	if err != nil {
+		return "", "", err
+	}
+
+	var syncToken, ctag string
+
+	for _, response := range result.Responses {
+		for _, propstat := range response.Propstats {
+			if !statusOK(propstat.Status) {
+				continue
+			}
+
+			syncToken = cmp.Or(syncToken, propstat.Prop.SyncToken)
+			ctag = cmp.Or(ctag, propstat.Prop.CTag)
+		}
+	}
+
+	return syncToken, ctag, nil
+}
+
+// calendarQuery returns the calendar objects of the collection with events that end after start, ordered by their
+// href. Recurring events are included if any of their occurrences do.
+func (c *caldavClient) calendarQuery(
+	ctx context.Context, collection *url.URL, start time.Time,
+) ([]calendarObject, error) {
+	body := fmt.Sprintf(reportCalendarQuery, start.UTC().Format("20060102T150405Z"))
+
+	result, err := c.do(ctx, "REPORT", collection, "1", body)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, _ := result.calendarObjects()
+
+	slices.SortFunc(objects, func(a, b calendarObject) int {
+		return strings.Compare(a.href, b.href)
+	})
+
+	return objects, nil
+}
+
+// syncCollection returns the calendar objects that were added or changed in the collection since the version of
+// syncToken, the hrefs of the removed ones and the new sync-token (RFC 6578). Servers may leave out the data of
+// changed objects, those are fetched with a calendar-multiget REPORT.
+func (c *caldavClient) syncCollection(
+	ctx context.Context, collection *url.URL, syncToken string,
+) ([]calendarObject, []string, string, error) {
+	var (
+		changed []calendarObject
+		removed []string
+		missing []string
+	)
+
+	for range maxSyncRequests {
+		result, err := c.do(ctx, "REPORT", collection, "0", fmt.Sprintf(reportSyncCollection, escapeXML(syncToken)))
+		if err != nil {
+			return nil, nil, "", err
+		}
+
+		syncToken = cmp.Or(result.SyncToken, syncToken)
+		truncated := false
+
+		for _, response := range result.Responses {
+			switch {
+			case isCollection(collection, response.Href):
+				// The collection itself is only reported if the server truncated the changes
+				truncated = strings.Contains(response.Status, "507")
+			case strings.Contains(response.Status, "404"):
+				removed = append(removed, response.Href)
+			}
+		}
+
+		objects, withoutData := result.calendarObjects()
+		changed = append(changed, objects...)
+
+		for _, href := range withoutData {
+			if !isCollection(collection, href) {
+				missing = append(missing, href)
+			}
+		}
+
+		if !truncated {
+			fetched, gone, err := c.calendarMultiget(ctx, collection, missing)
+			if err != nil {
+				return nil, nil, "", err
+			}
+
+			return append(changed, fetched...), append(removed, gone...), syncToken, nil
+		}
+	}
+
+	return nil, nil, "", errSyncTruncated
+}
+
+// calendarMultiget returns the calendar objects with the given hrefs, and the hrefs of the objects that don't exist
+// anymore.
+func (c *caldavClient) calendarMultiget(
+	ctx context.Context, collection *url.URL, hrefs []string,
+) ([]calendarObject, []string, error) {
+	if len(hrefs) == 0 {
+		return nil, nil, nil
+	}
+
+	var body strings.Builder
+	for _, href := range hrefs {
+		fmt.Fprintf(&body, "  <d:href>%s</d:href>\n", escapeXML(href))
+	}
+
+	result, err := c.do(ctx, "REPORT", collection, "", fmt.Sprintf(reportCalendarMultiget, body.String()))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects, _ := result.calendarObjects()
+
+	var gone []string
+
+	for _, href := range hrefs {
+		if !slices.ContainsFunc(objects, func(object calendarObject) bool { return object.href == href }) {
+			gone = append(gone, href)
+		}
+	}
+
+	return objects, gone, nil
+}
+
+func (c *caldavClient) do(
+	ctx context.Context, method string, collection *url.URL, depth, body string,
+) (*multistatus, error) {
+	req, err := http.NewRequestWithContext(ctx, method, collection.String(), strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	setCredentials(req, c.credentials)
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+
+	if depth != "" {
+		req.Header.Set("Depth", depth)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, statusCodeError(resp)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, c.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > c.maxSize {
+		return nil, &SizeLimitError{Limit: c.maxSize}
+	}
+
+	var result multistatus
+
+	err = xml.Unmarshal(data, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMultistatus, err)
+	}
+
+	return &result, nil
+}
+
+// calendarObjects returns the calendar objects with data in the multistatus, and the hrefs of the members without
+// data.
+func (m *multistatus) calendarObjects() ([]calendarObject, []string) {
+	var (
+		objects     []calendarObject
+		withoutData []string
+	)
+
+	for _, response := range m.Responses {
+		found := false
+
+		for _, propstat := range response.Propstats {
+			if !statusOK(propstat.Status) || propstat.Prop.CalendarData == "" {
+				continue
+			}
+
+			// XML parsers normalize line breaks to LF, iCal requires CRLF
+			data := strings.ReplaceAll(strings.ReplaceAll(propstat.Prop.CalendarData, "\r\n", "\n"), "\n", "\r\n")
+
+			objects = append(objects, calendarObject{href: response.Href, data: data})
+			found = true
+		}
+
+		if !found && len(response.Propstats) > 0 {
+			withoutData = append(withoutData, response.Href)
+		}
+	}
+
+	return objects, withoutData
+}
+
+// CalDAVSource fetches the events of CalDAV collections. A collection is only queried if its sync-token or ctag
+// changed since the last sync. The calendar objects of stored calendars are kept, so collections with a sync-token
+// are synced incrementally with a sync-collection REPORT (RFC 6578). Collections are queried in full on their first
+// sync, and if the server doesn't support incremental syncs or no longer accepts the sync-token.
+type CalDAVSource struct {
+	httpClient  *http.Client
+	credentials CredentialStore
+	objects     CalDAVObjectStore
+	sizeLimits  SizeLimits
+}
+
+func NewCalDAVSource(
+	httpClient *http.Client, credentials CredentialStore, objects CalDAVObjectStore, sizeLimits SizeLimits,
+) *CalDAVSource {
+	return &CalDAVSource{
+		httpClient:  httpClient,
+		credentials: credentials,
+		objects:     objects,
+		sizeLimits:  sizeLimits,
+	}
+}
+
+func (s *CalDAVSource) Fetch(ctx context.Context, calendar *pb.Calendar) (*ical.Calendar, SyncState, error) {
+	credentials, err := loadCredentials(ctx, s.credentials, calendar)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	collection, err := feedURL(calendar.GetCaldav().GetCollectionUrl())
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	limit := s.sizeLimits.of(calendar)
+	client := &caldavClient{
+		httpClient:  credentialClient(s.httpClient, credentials),
+		credentials: credentials,
+		maxSize:     limit,
+	}
+
+	syncToken, ctag, err := client.collectionState(ctx, collection)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	syncState := SyncState{Hash: calendar.LastSyncHash, SyncToken: syncToken, CTag: ctag}
+
+	if collectionUnchanged(calendar.GetCaldav(), syncToken, ctag) {
+		return nil, syncState, nil
+	}
+
+	objects, syncState, err := s.fetchObjects(ctx, client, calendar, collection, syncState)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	icalCalendar, hash, err := mergeCalendarObjects(objects, limit)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	syncState.Hash = hash
+	syncState.Size = objectsSize(objects)
+
+	// Changed objects are only stored by an import, even if the merged calendar stayed the same
+	if bytes.Equal(calendar.LastSyncHash, syncState.Hash) && syncState.CalDAVChanges.empty() {
+		return nil, syncState, nil
+	}
+
+	return icalCalendar, syncState, nil
+}
+
+// fetchObjects returns all calendar objects of the collection. The changes since the last sync are applied to the
+// stored objects if possible, the collection is queried in full otherwise. The changes to the stored objects are
+// returned with the sync state, they are stored together with its sync-token.
+func (s *CalDAVSource) fetchObjects(
+	ctx context.Context, client *caldavClient, calendar *pb.Calendar, collection *url.URL, syncState SyncState,
+) ([]calendarObject, SyncState, error) {
+	// Calendars that aren't stored, like those of previews, have no stored objects
+	var stored map[string]string
+
+	if calendar.Id != "" {
+		var err error
+
+		stored, err = s.objects.GetCalDAVObjects(ctx, calendar.Id)
+		if err != nil {
+			return nil, SyncState{}, fmt.Errorf("loading calendar objects: %w", err)
+		}
+	}
+
+	previousToken := calendar.GetCaldav().GetSyncToken()
+
+	if calendar.Id != "" && previousToken != "" && syncState.SyncToken != "" {
+		changed, removed, syncToken, err := client.syncCollection(ctx, collection, previousToken)
+
+		switch {
+		case err == nil:
+			syncState.SyncToken = syncToken
+			syncState.CalDAVChanges = CalDAVChanges{Changed: make(map[string]string, len(changed)), Removed: removed}
+
+			for _, href := range removed {
+				delete(stored, href)
+			}
+
+			for _, object := range changed {
+				stored[object.href] = object.data
+				syncState.CalDAVChanges.Changed[object.href] = object.data
+			}
+
+			return sortedObjects(stored), syncState, nil
+		case !syncUnsupported(err):
+			return nil, SyncState{}, err
+		}
+	}
+
+	objects, err := client.calendarQuery(ctx, collection, time.Now().Add(-caldavLookbehind))
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	// The stored objects are replaced by the queried ones, only those that differ are written
+	changes := CalDAVChanges{Changed: make(map[string]string)}
+
+	for _, object := range objects {
+		if data, ok := stored[object.href]; !ok || data != object.data {
+			changes.Changed[object.href] = object.data
+		}
+
+		delete(stored, object.href)
+	}
+
+	for href := range stored {
+		changes.Removed = append(changes.Removed, href)
+	}
+
+	syncState.CalDAVChanges = changes
+
+	return objects, syncState, nil
+}
+
+// syncUnsupported reports whether the server rejected a sync-collection REPORT because it doesn't support it, or
+// because the sync-token is no longer valid. The collection needs to be queried in full then.
+func syncUnsupported(err error) bool {
+	var statusCodeErr *StatusCodeError
+	if !errors.As(err, &statusCodeErr) {
+		return errors.Is(err, errSyncTruncated)
+	}
+
+	switch statusCodeErr.StatusCode {
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusConflict, http.StatusUnsupportedMediaType,
+		http.StatusNotImplemented:
+		return true
+	}
+
+	return false
+}
+
+// sortedObjects returns the calendar objects ordered by their href, like a calendar-query returns them.
+func sortedObjects(objects map[string]string) []calendarObject {
+	sorted := make([]calendarObject, 0, len(objects))
+	for href, data := range objects {
+		sorted = append(sorted, calendarObject{href: href, data: data})
+	}
+
+	slices.SortFunc(sorted, func(a, b calendarObject) int {
+		return strings.Compare(a.href, b.href)
+	})
+
+	return sorted
+}
+
+// isCollection reports whether href, which is usually only a path, refers to the collection itself.
+func isCollection(collection *url.URL, href string) bool {
+	ref, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSuffix(collection.ResolveReference(ref).Path, "/") == strings.TrimSuffix(collection.Path, "/")
+}
+
+// escapeXML escapes text for the content of an XML element.
+func escapeXML(text string) string {
+	var escaped strings.Builder
+
+	_ = xml.EscapeText(&escaped, []byte(text))
+
+	return escaped.String()
+}
+
+// collectionUnchanged reports whether the collection has the same version as on the last sync. The sync-token is
+// preferred, as the ctag is a non-standard extension.
+func collectionUnchanged(source *pb.CalDAVSource, syncToken, ctag string) bool {
+	if syncToken != "" {
+		return syncToken == source.SyncToken
+	}
+
+	return ctag != "" && ctag == source.Ctag
+}
+
+// mergeCalendarObjects combines the calendar objects into a single calendar, so they can be imported like an iCal
+// feed, and returns the hash of their content. Their total size must not exceed limit.
+func mergeCalendarObjects(objects []calendarObject, limit int64) (*ical.Calendar, []byte, error) {
+	merged := ical.NewCalendar()
+	merged.Props.SetText(ical.PropProductID, "-//ical-bot//ical-bot-backend//EN")
+	merged.Props.SetText(ical.PropVersion, "2.0")
+
+	timeZones := make(map[string]bool)
+	hash := sha256.New()
+
+	var size int64
+
+	for _, object := range objects {
+		size += int64(len(object.data))
+		if size > limit {
+			return nil, nil, &SizeLimitError{Limit: limit}
+		}
+
+		_, _ = io.WriteString(hash, object.href)
+		_, _ = io.WriteString(hash, object.data)
+
+		cal, err := ical.NewDecoder(strings.NewReader(object.data)).Decode()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s: %w", ErrInvalidIcal, object.href, err)
+		}
+
+		for _, child := range cal.Children {
+			if child.Name == ical.CompTimezone {
+				// Each object carries the time zones it uses, the definitions of a TZID are the same in all of them
+				tzid := child.Props.Get(ical.PropTimezoneID)
+				if tzid == nil || timeZones[tzid.Value] {
+					continue
+				}
+
+				timeZones[tzid.Value] = true
+			}
+
+			merged.Children = append(merged.Children, child)
+		}
+	}
+
+	return merged, hash.Sum(nil), nil
+}
+
+// statusOK reports whether a WebDAV status line, like "HTTP/1.1 200 OK", is successful.
+func statusOK(status string) bool {
+	fields := strings.Fields(status)
+
+	return len(fields) >= 2 && strings.HasPrefix(fields[1], "2")
+}
@@ -0,0 +1,427 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const caldavEvent = `BEGIN:VCALENDAR
+PRODID:test
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:Europe/Berlin
+BEGIN:STANDARD
+DTSTART:19701025T030000
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:%[1]s
+DTSTAMP:20250101T000000Z
+DTSTART;TZID=Europe/Berlin:20250310T090000
+SUMMARY:%[1]s
+END:VEVENT
+END:VCALENDAR
+`
+
+var (
+	syncTokenPattern = regexp.MustCompile(`<d:sync-token>(.*?)</d:sync-token>`)
+	hrefPattern      = regexp.MustCompile(`<d:href>(.*?)</d:href>`)
+)
+
+// caldavServer is a local stand-in for a CalDAV server with a single collection.
+type caldavServer struct {
+	syncToken string
+	objects   map[string]string
+	// changes are the hrefs of the objects that changed since each previous sync-token, objects that don't exist
+	// anymore were removed. Other sync-tokens are rejected.
+	changes map[string][]string
+	// omitData leaves out the data of changed objects in sync-collection responses, like some servers do
+	omitData bool
+
+	mu sync.Mutex
+	// reports counts the REPORTs by their name
+	reports map[string]int
+	// errs are the invalid requests. The handler can't fail the test, they are checked on the test goroutine.
+	errs []error
+}
+
+// reject records the invalid request and responds with 400 Bad Request.
+func (s *caldavServer) reject(w http.ResponseWriter, err error) {
+	s.mu.Lock()
+	s.errs = append(s.errs, err)
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusBadRequest)
+}
+
+// requireValid fails the test if the server received invalid requests, and returns the number of REPORTs by name.
+func (s *caldavServer) requireValid(t *testing.T) map[string]int {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	require.Empty(t, s.errs)
+
+	return maps.Clone(s.reports)
+}
+
+func (s *caldavServer) countReport(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.reports == nil {
+		s.reports = make(map[string]int)
+	}
+
+	s.reports[name]++
+}
+
+// objectResponse returns the response for the object, with its data unless withData is false, or a removed member.
+func (s *caldavServer) objectResponse(href string, withData bool) string {
+	data, ok := s.objects[href]
+
+	switch {
+	case !ok:
+		return fmt.Sprintf(`<d:response><d:href>%s</d:href><d:status>HTTP/1.1 404 Not Found</d:status></d:response>`,
+			href)
+	case !withData:
+		return fmt.Sprintf(`<d:response><d:href>%s</d:href>
+<d:propstat><d:prop><d:getetag>"1"</d:getetag></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>
+</d:response>`, href)
+	default:
+		return fmt.Sprintf(`<d:response><d:href>%s</d:href>
+<d:propstat><d:prop><c:calendar-data>%s</c:calendar-data></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>
+</d:response>`, href, data)
+	}
+}
+
+func (s *caldavServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.reject(w, err)
+
+		return
+	}
+
+	if user, password, ok := r.BasicAuth(); !ok || user != "alice" || password != "hunter2" {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	var responses strings.Builder
+
+	switch {
+	case r.Method == "PROPFIND" && r.Header.Get("Depth") == "0":
+		if !strings.Contains(string(body), "sync-token") {
+			s.reject(w, fmt.Errorf("PROPFIND without sync-token: %s", body))
+
+			return
+		}
+
+		fmt.Fprintf(&responses, `<d:response><d:href>/calendars/alice/team/</d:href>
+<d:propstat><d:prop><d:sync-token>%s</d:sync-token></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat>
+<d:propstat><d:prop><cs:getctag/></d:prop><d:status>HTTP/1.1 404 Not Found</d:status></d:propstat>
+</d:response>`, s.syncToken)
+	case r.Method == "REPORT" && r.Header.Get("Depth") == "1":
+		if !strings.Contains(string(body), "calendar-query") || !strings.Contains(string(body), `<c:time-range start="`) {
+			s.reject(w, fmt.Errorf("REPORT without calendar-query and time-range: %s", body))
+
+			return
+		}
+
+		s.countReport("calendar-query")
+
+		for href := range s.objects {
+			responses.WriteString(s.objectResponse(href, true))
+		}
+	case r.Method == "REPORT" && r.Header.Get("Depth") == "0":
+		match := syncTokenPattern.FindSubmatch(body)
+		if !strings.Contains(string(body), "sync-collection") || match == nil {
+			s.reject(w, fmt.Errorf("REPORT without sync-collection and sync-token: %s", body))
+
+			return
+		}
+
+		s.countReport("sync-collection")
+
+		changed, ok := s.changes[string(match[1])]
+		if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `<d:error xmlns:d="DAV:"><d:valid-sync-token/></d:error>`)
+
+			return
+		}
+
+		for _, href := range changed {
+			responses.WriteString(s.objectResponse(href, !s.omitData))
+		}
+
+		fmt.Fprintf(&responses, "<d:sync-token>%s</d:sync-token>", s.syncToken)
+	case r.Method == "REPORT" && r.Header.Get("Depth") == "":
+		if !strings.Contains(string(body), "calendar-multiget") {
+			s.reject(w, fmt.Errorf("REPORT without depth that isn't a calendar-multiget: %s", body))
+
+			return
+		}
+
+		s.countReport("calendar-multiget")
+
+		for _, match := range hrefPattern.FindAllSubmatch(body, -1) {
+			responses.WriteString(s.objectResponse(string(match[1]), true))
+		}
+	default:
+		s.reject(w, fmt.Errorf("unexpected %s request with depth %q", r.Method, r.Header.Get("Depth")))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?>
+<d:multistatus xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav" xmlns:cs="http://calendarserver.org/ns/">
+%s
+</d:multistatus>`, responses.String())
+}
+
+func TestCaldavClient(t *testing.T) {
+	caldav := &caldavServer{
+		syncToken: "http://example.com/sync/2",
+		objects: map[string]string{
+			"/calendars/alice/team/b.ics": fmt.Sprintf(caldavEvent, "b@example.com"),
+			"/calendars/alice/team/a.ics": fmt.Sprintf(caldavEvent, "a@example.com"),
+		},
+	}
+
+	server := httptest.NewServer(caldav)
+	defer server.Close()
+
+	collection, err := url.Parse(server.URL + "/calendars/alice/team/")
+	require.NoError(t, err)
+
+	client := &caldavClient{
+		httpClient: server.Client(),
+		credentials: &pb.FeedCredentials{
+			Authorization: &pb.FeedCredentials_BasicAuth_{
+				BasicAuth: &pb.FeedCredentials_BasicAuth{Username: "alice", Password: "hunter2"},
+			},
+		},
+		maxSize: testMaxIcalSize,
+	}
+
+	syncToken, ctag, err := client.collectionState(context.Background(), collection)
+	require.NoError(t, err)
+	require.Equal(t, "http://example.com/sync/2", syncToken)
+	require.Empty(t, ctag)
+
+	objects, err := client.calendarQuery(context.Background(), collection, time.Now())
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "/calendars/alice/team/a.ics", objects[0].href)
+	require.Equal(t, map[string]int{"calendar-query": 1}, caldav.requireValid(t))
+
+	merged, hash, err := mergeCalendarObjects(objects, testMaxIcalSize)
+	require.NoError(t, err)
+	require.Len(t, hash, 32)
+
+	events := merged.Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "a@example.com", events[0].Props.Get("UID").Value)
+
+	// Both objects define the same time zone, the merged calendar only needs it once
+	require.Len(t, merged.Children, 3)
+
+	feed, err := newFeed(merged, time.UTC)
+	require.NoError(t, err)
+
+	start, err := feed.zones.EventStart(&events[0])
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), start.UTC())
+}
+
+func TestIcalImport_CalDAVUnchanged(t *testing.T) {
+	caldav := &caldavServer{syncToken: "http://example.com/sync/1"}
+
+	server := httptest.NewServer(caldav)
+	defer server.Close()
+
+	calendarRepo := &fakeCalendarRepository{
+		credentials: &pb.FeedCredentials{
+			Authorization: &pb.FeedCredentials_BasicAuth_{
+				BasicAuth: &pb.FeedCredentials_BasicAuth{Username: "alice", Password: "hunter2"},
+			},
+		},
+	}
+	source := NewCalDAVSource(server.Client(), calendarRepo, fakeObjectStore{}, testSizeLimits)
+	importer := NewIcalImport(
+		&unusedEventRepository{t: t}, calendarRepo, Sources{CalDAV: source}, nil, time.Hour, config.Imports{},
+		slog.New(slog.DiscardHandler),
+	)
+
+	_, err := importer.importCalendar(context.Background(), &pb.Calendar{
+		Id:             "calendar-1",
+		HasCredentials: true,
+		Source: &pb.Calendar_Caldav{Caldav: &pb.CalDAVSource{
+			CollectionUrl: server.URL + "/calendars/alice/team/",
+			SyncToken:     "http://example.com/sync/1",
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, caldav.requireValid(t))
+
+	require.Len(t, calendarRepo.updates, 1)
+	require.Equal(t, "http://example.com/sync/1", calendarRepo.updates[0].GetCaldav().GetSyncToken())
+	require.Contains(t, calendarRepo.masks[0].Paths, "caldav.sync_token")
+}
+
+// fakeObjectStore holds the stored calendar objects of every calendar, by their href.
+type fakeObjectStore map[string]map[string]string
+
+func (f fakeObjectStore) GetCalDAVObjects(_ context.Context, calendarID string) (map[string]string, error) {
+	objects := maps.Clone(f[calendarID])
+	if objects == nil {
+		objects = make(map[string]string)
+	}
+
+	return objects, nil
+}
+
+func TestCalDAVSource_Fetch(t *testing.T) {
+	// Objects are stored with CRLF line breaks, like they are read from responses
+	object := func(uid string) string {
+		return strings.ReplaceAll(fmt.Sprintf(caldavEvent, uid), "\n", "\r\n")
+	}
+
+	const (
+		hrefA = "/calendars/alice/team/a.ics"
+		hrefB = "/calendars/alice/team/b.ics"
+		hrefC = "/calendars/alice/team/c.ics"
+	)
+
+	testcases := []struct {
+		Name string
+
+		CalendarID    string
+		PreviousToken string
+		Stored        map[string]string
+		OmitData      bool
+
+		ExpectedReports map[string]int
+		ExpectedUIDs    []string
+		ExpectedChanged []string
+		ExpectedRemoved []string
+	}{{
+		Name:            "First sync",
+		CalendarID:      "calendar-1",
+		ExpectedReports: map[string]int{"calendar-query": 1},
+		ExpectedUIDs:    []string{"a-new", "b"},
+		ExpectedChanged: []string{hrefA, hrefB},
+	}, {
+		Name:            "Changes since the last sync",
+		CalendarID:      "calendar-1",
+		PreviousToken:   "http://example.com/sync/1",
+		Stored:          map[string]string{hrefA: object("a"), hrefC: object("c")},
+		ExpectedReports: map[string]int{"sync-collection": 1},
+		ExpectedUIDs:    []string{"a-new", "b"},
+		ExpectedChanged: []string{hrefA, hrefB},
+		ExpectedRemoved: []string{hrefC},
+	}, {
+		Name:            "Changes without their data",
+		CalendarID:      "calendar-1",
+		PreviousToken:   "http://example.com/sync/1",
+		Stored:          map[string]string{hrefA: object("a"), hrefC: object("c")},
+		OmitData:        true,
+		ExpectedReports: map[string]int{"sync-collection": 1, "calendar-multiget": 1},
+		ExpectedUIDs:    []string{"a-new", "b"},
+		ExpectedChanged: []string{hrefA, hrefB},
+		ExpectedRemoved: []string{hrefC},
+	}, {
+		Name:            "Invalid sync-token",
+		CalendarID:      "calendar-1",
+		PreviousToken:   "http://example.com/sync/0",
+		Stored:          map[string]string{hrefA: object("a"), hrefB: object("b"), hrefC: object("c")},
+		ExpectedReports: map[string]int{"sync-collection": 1, "calendar-query": 1},
+		ExpectedUIDs:    []string{"a-new", "b"},
+		ExpectedChanged: []string{hrefA},
+		ExpectedRemoved: []string{hrefC},
+	}, {
+		Name:            "Preview",
+		PreviousToken:   "http://example.com/sync/1",
+		ExpectedReports: map[string]int{"calendar-query": 1},
+		ExpectedUIDs:    []string{"a-new", "b"},
+		ExpectedChanged: []string{hrefA, hrefB},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			caldav := &caldavServer{
+				syncToken: "http://example.com/sync/2",
+				objects:   map[string]string{hrefA: object("a-new"), hrefB: object("b")},
+				changes:   map[string][]string{"http://example.com/sync/1": {hrefA, hrefB, hrefC}},
+				omitData:  testcase.OmitData,
+			}
+
+			server := httptest.NewServer(caldav)
+			defer server.Close()
+
+			calendarRepo := &fakeCalendarRepository{
+				credentials: &pb.FeedCredentials{
+					Authorization: &pb.FeedCredentials_BasicAuth_{
+						BasicAuth: &pb.FeedCredentials_BasicAuth{Username: "alice", Password: "hunter2"},
+					},
+				},
+			}
+			objects := fakeObjectStore{"calendar-1": testcase.Stored}
+			source := NewCalDAVSource(server.Client(), calendarRepo, objects, testSizeLimits)
+
+			icalCalendar, syncState, err := source.Fetch(context.Background(), &pb.Calendar{
+				Id:             testcase.CalendarID,
+				HasCredentials: true,
+				Source: &pb.Calendar_Caldav{Caldav: &pb.CalDAVSource{
+					CollectionUrl: server.URL + "/calendars/alice/team/",
+					SyncToken:     testcase.PreviousToken,
+				}},
+			})
+			require.NoError(t, err)
+			require.Equal(t, testcase.ExpectedReports, caldav.requireValid(t))
+			require.Equal(t, "http://example.com/sync/2", syncState.SyncToken)
+
+			uids := make([]string, 0, len(icalCalendar.Events()))
+			for _, event := range icalCalendar.Events() {
+				uid, err := event.Props.Text("UID")
+				require.NoError(t, err)
+
+				uids = append(uids, uid)
+			}
+
+			require.Equal(t, testcase.ExpectedUIDs, uids)
+
+			changed := slices.Sorted(maps.Keys(syncState.CalDAVChanges.Changed))
+			require.Equal(t, testcase.ExpectedChanged, changed)
+
+			removed := slices.Clone(syncState.CalDAVChanges.Removed)
+			slices.Sort(removed)
+			require.Equal(t, testcase.ExpectedRemoved, removed)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/emersion/go-ical"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var ErrInvalidPath = errors.New("invalid file path")
+
+// FileSource reads calendars from the local file system, e.g. from a mounted share. The path of a calendar is
+// relative to the root and may be a single iCal file or a directory, whose .ics files are imported together.
+type FileSource struct {
+	root       *os.Root
+	sizeLimits SizeLimits
+}
+
+func NewFileSource(root *os.Root, sizeLimits SizeLimits) *FileSource {
+	return &FileSource{
+		root:       root,
+		sizeLimits: sizeLimits,
+	}
+}
+
+func (s *FileSource) Fetch(_ context.Context, calendar *pb.Calendar) (*ical.Calendar, SyncState, error) {
+	name := path.Clean("/" + calendar.GetFile().GetPath())[1:]
+	if name == "" {
+		name = "."
+	}
+
+	if !fs.ValidPath(name) {
+		return nil, SyncState{}, fmt.Errorf("%w: %q", ErrInvalidPath, calendar.GetFile().GetPath())
+	}
+
+	fsys := s.root.FS()
+
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	syncState := SyncState{Hash: calendar.LastSyncHash}
+	limit := s.sizeLimits.of(calendar)
+
+	if !info.IsDir() {
+		if info.Size() > limit {
+			return nil, SyncState{}, &SizeLimitError{Limit: limit}
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, SyncState{}, err
+		}
+
+		return decodeFeed(calendar, data, syncState, limit)
+	}
+
+	objects, err := readCalendarObjects(fsys, name, limit)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	icalCalendar, hash, err := mergeCalendarObjects(objects, limit)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	syncState.Hash = hash
+	syncState.Size = objectsSize(objects)
+
+	if bytes.Equal(calendar.LastSyncHash, syncState.Hash) {
+		return nil, syncState, nil
+	}
+
+	return icalCalendar, syncState, nil
+}
+
+// readCalendarObjects reads the .ics files of the directory, ordered by name. Subdirectories are not traversed. The
+// total size of the files must not exceed limit.
+func readCalendarObjects(fsys fs.FS, dir string, limit int64) ([]calendarObject, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		objects []calendarObject
+		size    int64
+	)
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.EqualFold(path.Ext(entry.Name()), ".ics") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		size += info.Size()
+		if size > limit {
+			return nil, &SizeLimitError{Limit: limit}
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, calendarObject{href: entry.Name(), data: string(data)})
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,201 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// defaultMaxRedirects is the number of redirects that net/http follows for clients without a redirect policy.
+const defaultMaxRedirects = 10
+
+var errTooManyRedirects = errors.New("too many redirects")
+
+// HTTPSource fetches iCal feeds over HTTP. Conditional requests with the validators of the last sync spare
+// hosts from sending unchanged feeds.
+type HTTPSource struct {
+	httpClient  *http.Client
+	credentials CredentialStore
+	sizeLimits  SizeLimits
+}
+
+func NewHTTPSource(httpClient *http.Client, credentials CredentialStore, sizeLimits SizeLimits) *HTTPSource {
+	return &HTTPSource{
+		httpClient:  httpClient,
+		credentials: credentials,
+		sizeLimits:  sizeLimits,
+	}
+}
+
+func (s *HTTPSource) Fetch(ctx context.Context, calendar *pb.Calendar) (*ical.Calendar, SyncState, error) {
+	credentials, err := loadCredentials(ctx, s.credentials, calendar)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	icalURL, err := feedURL(cmp.Or(calendar.GetHttp().GetUrl(), calendar.IcalUrl))
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, icalURL.String(), http.NoBody)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	setCredentials(req, credentials)
+
+	if calendar.HttpEtag != "" {
+		req.Header.Set("If-None-Match", calendar.HttpEtag)
+	}
+
+	if calendar.HttpLastModified != "" {
+		req.Header.Set("If-Modified-Since", calendar.HttpLastModified)
+	}
+
+	resp, err := credentialClient(s.httpClient, credentials).Do(req)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	defer resp.Body.Close()
+
+	syncState := SyncState{
+		Hash:         calendar.LastSyncHash,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		HTTPStatus:   resp.StatusCode,
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		// Hosts may omit validators on 304 responses, in which case the stored ones are still current
+		syncState.ETag = cmp.Or(syncState.ETag, calendar.HttpEtag)
+		syncState.LastModified = cmp.Or(syncState.LastModified, calendar.HttpLastModified)
+
+		return nil, syncState, nil
+	}
+
+	if resp.StatusCode > 299 {
+		return nil, SyncState{}, statusCodeError(resp)
+	}
+
+	limit := s.sizeLimits.of(calendar)
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	return decodeFeed(calendar, body, syncState, limit)
+}
+
+// feedURL parses the URL of a feed. webcal and webcals URLs, which calendar apps register for subscriptions, are
+// fetched over HTTP and HTTPS.
+func feedURL(rawURL string) (*url.URL, error) {
+	icalURL, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(icalURL.Scheme) {
+	case "http", "https":
+	case "webcal":
+		icalURL.Scheme = "http"
+	case "webcals":
+		icalURL.Scheme = "https"
+	default:
+		return nil, &url.Error{Op: "parse", URL: rawURL, Err: ErrUnsupportedScheme}
+	}
+
+	return icalURL, nil
+}
+
+// statusCodeError returns the error of a non-successful response, with the delay the host asked for until the next
+// request.
+func statusCodeError(resp *http.Response) *StatusCodeError {
+	return &StatusCodeError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date. Invalid
+// values and dates in the past are ignored.
+func retryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		// Larger values would overflow, the delay is capped far below anyway
+		return time.Duration(min(max(seconds, 0), int64(math.MaxInt64/time.Second))) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0
+	}
+
+	return max(date.Sub(now), 0)
+}
+
+// setCredentials adds the credentials to the request for a feed. The Authorization header of basic auth or a bearer
+// token takes precedence over a custom one.
+func setCredentials(req *http.Request, credentials *pb.FeedCredentials) {
+	for name, value := range credentials.GetHeaders() {
+		req.Header.Set(name, value)
+	}
+
+	switch {
+	case credentials.GetBasicAuth() != nil:
+		req.SetBasicAuth(credentials.GetBasicAuth().Username, credentials.GetBasicAuth().Password)
+	case credentials.GetBearerToken() != "":
+		req.Header.Set("Authorization", "Bearer "+credentials.GetBearerToken())
+	}
+}
+
+// credentialClient returns a copy of the client that removes the credential headers from redirects to another host,
+// including another port. net/http only removes the Authorization header, and not on redirects to subdomains, while
+// custom headers like API keys would be sent to any redirect target.
+func credentialClient(client *http.Client, credentials *pb.FeedCredentials) *http.Client {
+	if credentials == nil {
+		return client
+	}
+
+	checkRedirect := client.CheckRedirect
+	withCredentials := *client
+	withCredentials.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !strings.EqualFold(req.URL.Host, via[0].URL.Host) {
+			req.Header.Del("Authorization")
+
+			for name := range credentials.GetHeaders() {
+				req.Header.Del(name)
+			}
+		}
+
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+
+		if len(via) >= defaultMaxRedirects {
+			return fmt.Errorf("%w: stopped after %d", errTooManyRedirects, defaultMaxRedirects)
+		}
+
+		return nil
+	}
+
+	return &withCredentials
+}
@@ -4,54 +4,73 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
-	"net/url"
 	"time"
 
 	"github.com/emersion/go-ical"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/calendar"
 	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
 )
 
 var (
 	ErrUnexpectedStatusCode = errors.New("unexpected HTTP status code")
 	ErrIcalSizeExceeded     = errors.New("ical exceeded the maximum allowed size")
+	ErrTooManyEvents        = errors.New("calendar exceeded the maximum number of events")
+	ErrUnsupportedScheme    = errors.New("unsupported URL scheme")
+	ErrImportInProgress     = errors.New("calendar is being imported")
 )
 
 type CalendarRepository interface {
+	GetCalendar(ctx context.Context, id string) (*pb.Calendar, error)
 	ListCalendars(
 		ctx context.Context, pageSize int32, pageToken *pb.PageToken, filter *pb.ListCalendarsFilter,
 	) ([]*pb.Calendar, *pb.PageToken, error)
+	UpdateCalendar(ctx context.Context, calendar *pb.Calendar, mask *fieldmaskpb.FieldMask) (*pb.Calendar, error)
 }
 
 type EventRepository interface {
-	StartImport(ctx context.Context, calendarID string) (*Import, error)
+	StartImport(ctx context.Context, calendarID string, syncState SyncState) (*Import, error)
+	RecordImport(ctx context.Context, record *pb.CalendarImport) error
+	DeleteImportsBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 type IcalImport struct {
 	eventRepo    EventRepository
 	calendarRepo CalendarRepository
-	httpClient   *http.Client
-	logger       *slog.Logger
+	sources      Sources
+	// ignoredAlarmActions are only needed for previews, imports leave them to the event repository
+	ignoredAlarmActions []string
+	// horizon is how far ahead the alarms of imported events are materialized
+	horizon time.Duration
+	cfg     config.Imports
+	logger  *slog.Logger
 }
 
 func NewIcalImport(
-	eventRepo EventRepository, calendarRepo CalendarRepository, httpClient *http.Client, logger *slog.Logger,
+	eventRepo EventRepository, calendarRepo CalendarRepository, sources Sources,
+	ignoredAlarmActions []string, horizon time.Duration, cfg config.Imports, logger *slog.Logger,
 ) *IcalImport {
 	return &IcalImport{
 		eventRepo:    eventRepo,
 		calendarRepo: calendarRepo,
-		httpClient:   httpClient,
+		sources:      sources,
+		horizon:      horizon,
+		cfg:          cfg,
 		logger:       logger,
+
+		ignoredAlarmActions: ignoredAlarmActions,
 	}
 }
 
 func (i *IcalImport) Run(ctx context.Context) error {
 	nextPageToken := &pb.PageToken{}
+	now := time.Now()
 
 	for {
 		var (
@@ -59,25 +78,31 @@ func (i *IcalImport) Run(ctx context.Context) error {
 			err       error
 		)
 
-		calendars, nextPageToken, err = i.calendarRepo.ListCalendars(ctx, 100, nextPageToken, &pb.ListCalendarsFilter{
-			LastSyncTimeBefore: timestamppb.New(time.Now().Add(-5 * time.Minute)),
-		})
+		calendars, nextPageToken, err = i.calendarRepo.ListCalendars(
+			ctx, i.cfg.PageSize, nextPageToken, &pb.ListCalendarsFilter{NextSyncTimeBefore: timestamppb.New(now)},
+		)
 		if err != nil {
 			return err
 		}
 
 		eg := errgroup.Group{}
-		eg.SetLimit(8)
+		eg.SetLimit(i.cfg.Concurrency)
 
 		for _, cal := range calendars {
 			eg.Go(func() error {
-				err := i.importCalendar(ctx, cal)
+				err := i.syncCalendar(ctx, cal.Id, now)
 				if err != nil {
 					// Log, but don't return an error. We don't want to stop processing all calendars just because one is broken
 					i.logger.Error("failed to import calendar",
 						slog.String("calendar_id", cal.Id),
 						slog.String("ical_url", cal.IcalUrl),
+						log.Error(err),
 					)
+
+					err = i.recordSyncError(ctx, cal, err)
+					if err != nil {
+						i.logger.Error("failed to record sync error", slog.String("calendar_id", cal.Id), log.Error(err))
+					}
 				}
 
 				return nil
@@ -94,63 +119,429 @@ func (i *IcalImport) Run(ctx context.Context) error {
 		}
 	}
 
+	deleted, err := i.eventRepo.DeleteImportsBefore(ctx, time.Now().Add(-i.cfg.HistoryRetention))
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		i.logger.DebugContext(ctx, "deleted expired import history", slog.Int64("imports", deleted))
+	}
+
 	return nil
 }
 
-func (i *IcalImport) importCalendar(ctx context.Context, calendar *pb.Calendar) error {
-	icalURL, err := url.ParseRequestURI(calendar.IcalUrl)
+// calendarLockKey is the key of the lock that must be held to write the events and alarms of a calendar.
+func calendarLockKey(calendarID string) string {
+	return "calendar:" + calendarID
+}
+
+// sizeLimits returns the size limits of iCal data that is passed to the import directly, instead of being fetched by
+// a source.
+func (i *IcalImport) sizeLimits() SizeLimits {
+	return SizeLimits{Default: i.cfg.MaxIcalSize, Max: i.cfg.MaxIcalSizeOverride}
+}
+
+// syncCalendar imports the calendar, unless another replica is importing it right now or has imported it since
+// it was listed as due.
+func (i *IcalImport) syncCalendar(ctx context.Context, calendarID string, now time.Time) error {
+	logger := i.logger.With(slog.String("calendar_id", calendarID))
+
+	// The listed calendar may be outdated by now
+	cal, err := i.calendarRepo.GetCalendar(ctx, calendarID)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, icalURL.String(), http.NoBody)
+	if cal.NextSyncTime != nil && cal.NextSyncTime.AsTime().After(now) {
+		logger.DebugContext(ctx, "calendar was imported by another replica, skipping")
+
+		return nil
+	}
+
+	_, err = i.importCalendar(ctx, cal)
+	if errors.Is(err, ErrImportInProgress) {
+		logger.DebugContext(ctx, "calendar is being imported by another replica, skipping")
+
+		return nil
+	}
+
+	return err
+}
+
+// Sync imports the calendar right away, regardless of when it was synced last. A failed import is recorded as the
+// sync error of the calendar and returned as the error status of the response. The returned error is only set if the
+// import couldn't be attempted, e.g. because another import of the calendar is in progress.
+func (i *IcalImport) Sync(ctx context.Context, calendarID string) (*pb.SyncCalendarResponse, error) {
+	cal, err := i.calendarRepo.GetCalendar(ctx, calendarID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	result, importErr := i.importCalendar(ctx, cal)
+	if errors.Is(importErr, ErrImportInProgress) {
+		return nil, importErr
 	}
 
-	resp, err := i.httpClient.Do(req)
+	if importErr != nil {
+		err = i.recordSyncError(ctx, cal, importErr)
+		if err != nil {
+			return nil, errors.Join(importErr, fmt.Errorf("recording sync error: %w", err))
+		}
+
+		return &pb.SyncCalendarResponse{Error: syncErrorStatus(importErr)}, nil
+	}
+
+	return &pb.SyncCalendarResponse{
+		Unchanged:       result.Unchanged,
+		EventsImported:  int32(result.EventsImported),  //nolint:gosec // bounded by the maximum feed size
+		AlarmsScheduled: int32(result.AlarmsScheduled), //nolint:gosec // bounded by the maximum feed size
+	}, nil
+}
+
+// ImportData stores the iCal data as the inline source of the calendar and imports its events. Invalid data,
+// including single invalid events and data above the event limit, is rejected before the source of the calendar is
+// changed. If the import fails nonetheless, it is recorded as the sync error of the calendar. If another import of the
+// calendar is in progress, ErrImportInProgress is returned after the data was stored, it is imported by the next sync.
+func (i *IcalImport) ImportData(ctx context.Context, calendarID string, data []byte) (*pb.Calendar, error) {
+	cal, err := i.calendarRepo.GetCalendar(ctx, calendarID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	defer resp.Body.Close()
+	start := time.Now()
 
-	if resp.StatusCode > 299 {
-		return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, resp.StatusCode)
+	icalCalendar, syncState, err := decodeFeed(
+		cal, data, SyncState{Hash: cal.LastSyncHash}, i.sizeLimits().of(cal),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	lr := &io.LimitedReader{
-		R: resp.Body,
-		N: 10 * 1024 * 1024, // ToDo: define a sensible max size
+	// Unchanged data was imported successfully before
+	if icalCalendar != nil {
+		err = i.validateEvents(cal, icalCalendar)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	decoder := ical.NewDecoder(lr)
+	cal, err = i.calendarRepo.UpdateCalendar(ctx, &pb.Calendar{
+		Id:     calendarID,
+		Source: &pb.Calendar_Inline{Inline: &pb.InlineSource{Data: data}},
+	}, &fieldmaskpb.FieldMask{Paths: []string{"inline.data"}})
+	if err != nil {
+		return nil, err
+	}
+
+	result, importErr := i.applyFeed(ctx, cal, icalCalendar, syncState)
+	if errors.Is(importErr, ErrImportInProgress) {
+		return nil, importErr
+	}
+
+	i.recordImport(ctx, calendarID, start, result, importErr)
 
-	icalCalendar, err := decoder.Decode()
-	if errors.Is(err, io.EOF) && lr.N == 0 {
-		return ErrIcalSizeExceeded
+	if importErr != nil {
+		err = i.recordSyncError(ctx, cal, importErr)
+		if err != nil {
+			return nil, errors.Join(importErr, fmt.Errorf("recording sync error: %w", err))
+		}
+
+		return nil, importErr
 	}
+
+	return i.calendarRepo.GetCalendar(ctx, calendarID)
+}
+
+// ImportResult is the outcome of a successful import of a calendar.
+type ImportResult struct {
+	// Unchanged is set if the feed didn't change since the last sync, its stored events were kept as they are
+	Unchanged bool
+	// HTTPStatus and BytesFetched describe the fetch of the feed, HTTPStatus is only set for HTTP sources
+	HTTPStatus   int
+	BytesFetched int64
+	// EventsImported are all events of the feed, EventsAdded and EventsUpdated the ones that weren't stored as they
+	// are yet
+	EventsImported  int
+	EventsAdded     int
+	EventsUpdated   int
+	EventsRemoved   int
+	AlarmsScheduled int
+}
+
+// importCalendar fetches the feed of the calendar from its source and imports its events, unless it didn't change
+// since the last sync. Each attempt is recorded in the import history of the calendar, except for those that found
+// another import of the calendar in progress.
+//
+// The feed is fetched before the lock of the calendar is taken, so imports hold no database connection while waiting
+// for the feed host. Concurrent imports may fetch the same feed, but only one of them stores its events at a time.
+func (i *IcalImport) importCalendar(ctx context.Context, calendar *pb.Calendar) (ImportResult, error) {
+	start := time.Now()
+
+	result, err := i.fetchAndImport(ctx, calendar)
+	if errors.Is(err, ErrImportInProgress) {
+		return result, err
+	}
+
+	i.recordImport(ctx, calendar.Id, start, result, err)
+
+	return result, err
+}
+
+func (i *IcalImport) fetchAndImport(ctx context.Context, calendar *pb.Calendar) (ImportResult, error) {
+	source, err := i.sources.of(calendar)
 	if err != nil {
-		return err
+		return ImportResult{}, err
 	}
 
-	importOperation, err := i.eventRepo.StartImport(ctx, calendar.Id)
+	icalCalendar, syncState, err := source.Fetch(ctx, calendar)
 	if err != nil {
-		return err
+		return ImportResult{}, err
+	}
+
+	return i.applyFeed(ctx, calendar, icalCalendar, syncState)
+}
+
+// applyFeed imports the events of the fetched feed, or only records the sync if the feed is nil because it didn't
+// change.
+func (i *IcalImport) applyFeed(
+	ctx context.Context, calendar *pb.Calendar, icalCalendar *ical.Calendar, syncState SyncState,
+) (ImportResult, error) {
+	var (
+		result ImportResult
+		err    error
+	)
+
+	syncState.NextSyncTime = time.Now().Add(i.syncInterval(calendar))
+
+	if icalCalendar == nil {
+		result.Unchanged = true
+		err = i.markUnchanged(ctx, calendar, syncState)
+	} else {
+		result, err = i.importEvents(ctx, calendar, icalCalendar, syncState)
+	}
+
+	result.HTTPStatus = syncState.HTTPStatus
+	result.BytesFetched = syncState.Size
+
+	return result, err
+}
+
+// recordImport adds an import attempt to the import history of the calendar. The import is done by then, so a
+// failure to record it is only logged.
+func (i *IcalImport) recordImport(
+	ctx context.Context, calendarID string, start time.Time, result ImportResult, importErr error,
+) {
+	//nolint:gosec // counts are bounded by the maximum feed size
+	record := &pb.CalendarImport{
+		CalendarId:      calendarID,
+		StartTime:       timestamppb.New(start),
+		EndTime:         timestamppb.Now(),
+		HttpStatus:      int32(result.HTTPStatus),
+		BytesFetched:    result.BytesFetched,
+		Unchanged:       result.Unchanged,
+		EventsAdded:     int32(result.EventsAdded),
+		EventsUpdated:   int32(result.EventsUpdated),
+		EventsRemoved:   int32(result.EventsRemoved),
+		AlarmsScheduled: int32(result.AlarmsScheduled),
+	}
+
+	if importErr != nil {
+		record.Error = syncErrorStatus(importErr)
+
+		var statusCodeErr *StatusCodeError
+		if errors.As(importErr, &statusCodeErr) {
+			record.HttpStatus = int32(statusCodeErr.StatusCode) //nolint:gosec // HTTP status codes have 3 digits
+		}
+	}
+
+	err := i.eventRepo.RecordImport(ctx, record)
+	if err != nil {
+		i.logger.ErrorContext(ctx, "failed to record import", slog.String("calendar_id", calendarID), log.Error(err))
+	}
+}
+
+// importEvents replaces the stored events of the calendar with the events of the fetched feed.
+func (i *IcalImport) importEvents(
+	ctx context.Context, calendar *pb.Calendar, icalCalendar *ical.Calendar, syncState SyncState,
+) (ImportResult, error) {
+	floating, err := floatingLocation(calendar)
+	if err != nil {
+		return ImportResult{}, err
 	}
 
-	defer func() {
-		_ = importOperation.Close(err)
-	}()
+	feed, err := newFeed(icalCalendar, floating)
+	if err != nil {
+		return ImportResult{}, fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	importOperation, err := i.eventRepo.StartImport(ctx, calendar.Id, syncState)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	now := time.Now()
+	window := timerange{from: now, to: now.Add(i.horizon)}
 
 	for _, ev := range icalCalendar.Events() {
-		fmt.Printf("%#v\n", ev.Props.Get("SUMMARY"))
+		err = importOperation.UpsertEvent(ctx, calendar, &ev, feed, window)
+		if err == nil && i.cfg.MaxEvents > 0 && importOperation.Result().EventsImported > i.cfg.MaxEvents {
+			err = &EventLimitError{Limit: i.cfg.MaxEvents}
+		}
 
-		err = importOperation.CreateEvent(ctx, calendar, &ev)
 		if err != nil {
-			return err
+			_ = importOperation.Close(err)
+
+			return ImportResult{}, err
+		}
+	}
+
+	err = importOperation.Close(nil)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	return importOperation.Result(), nil
+}
+
+// validateEvents checks the events of the feed like importEvents does, without storing them.
+func (i *IcalImport) validateEvents(calendar *pb.Calendar, icalCalendar *ical.Calendar) error {
+	floating, err := floatingLocation(calendar)
+	if err != nil {
+		return err
+	}
+
+	feed, err := newFeed(icalCalendar, floating)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	now := time.Now()
+	window := timerange{from: now, to: now.Add(i.horizon)}
+	imported := 0
+
+	for _, event := range icalCalendar.Events() {
+		ended, err := validateEvent(calendar, &event, feed, i.ignoredAlarmActions, window)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+		}
+
+		// Like the import, the limit only counts the events that are stored
+		if !ended {
+			imported++
+		}
+
+		if i.cfg.MaxEvents > 0 && imported > i.cfg.MaxEvents {
+			return &EventLimitError{Limit: i.cfg.MaxEvents}
 		}
 	}
 
 	return nil
 }
+
+// validateEvent runs the steps of Import.UpsertEvent that can fail for the event itself. It reports whether the
+// event ended already, which isn't stored.
+func validateEvent(
+	calendar *pb.Calendar, event *ical.Event, feed *feed, ignoredActions []string, window timerange,
+) (bool, error) {
+	_, _, err := eventIdentity(event, feed.zones)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = encodeEvent("", event, feed.zones.Definitions(event.Component))
+	if err != nil {
+		return false, err
+	}
+
+	ended, err := eventEnded(event, feed.zones, window.from)
+	if err != nil || ended {
+		return ended, err
+	}
+
+	_, err = calculateNextAlarms(calendar, "", event, feed, ignoredActions, window)
+
+	return false, err
+}
+
+// markUnchanged records a sync of a feed whose content didn't change since the last import, without touching
+// its events.
+func (i *IcalImport) markUnchanged(ctx context.Context, calendar *pb.Calendar, syncState SyncState) error {
+	i.logger.DebugContext(ctx, "calendar unchanged, skipping import", slog.String("calendar_id", calendar.Id))
+
+	update := &pb.Calendar{
+		Id:               calendar.Id,
+		LastSyncTime:     timestamppb.Now(),
+		HttpEtag:         syncState.ETag,
+		HttpLastModified: syncState.LastModified,
+		NextSyncTime:     timestamppb.New(syncState.NextSyncTime),
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{
+		"last_sync_time", "http_etag", "http_last_modified", "last_sync_error", "next_sync_time", "sync_failures",
+	}}
+
+	if calendar.GetCaldav() != nil {
+		update.Source = &pb.Calendar_Caldav{
+			Caldav: &pb.CalDAVSource{SyncToken: syncState.SyncToken, Ctag: syncState.CTag},
+		}
+		mask.Paths = append(mask.Paths, "caldav.sync_token", "caldav.ctag")
+	}
+
+	_, err := i.calendarRepo.UpdateCalendar(ctx, update, mask)
+
+	return err
+}
+
+// recordSyncError stores the reason of a failed import on the calendar and delays its next sync. Both are reset
+// by the next successful sync.
+func (i *IcalImport) recordSyncError(ctx context.Context, calendar *pb.Calendar, importErr error) error {
+	failures := calendar.SyncFailures + 1
+
+	_, err := i.calendarRepo.UpdateCalendar(ctx, &pb.Calendar{
+		Id:            calendar.Id,
+		LastSyncError: syncErrorStatus(importErr),
+		NextSyncTime:  timestamppb.New(time.Now().Add(i.retryDelay(calendar, failures, importErr))),
+		SyncFailures:  failures,
+	}, &fieldmaskpb.FieldMask{Paths: []string{"last_sync_error", "next_sync_time", "sync_failures"}})
+
+	return err
+}
+
+// syncInterval returns the time between two syncs of the calendar.
+func (i *IcalImport) syncInterval(calendar *pb.Calendar) time.Duration {
+	if calendar.SyncInterval == nil {
+		return i.cfg.SyncInterval
+	}
+
+	return calendar.SyncInterval.AsDuration()
+}
+
+// retryDelay returns the time until the next sync of the calendar after the given number of consecutive failed
+// imports. The sync interval doubles with each failure up to the maximum backoff, but a delay the feed host asked
+// for is honored up to its own maximum.
+func (i *IcalImport) retryDelay(calendar *pb.Calendar, failures int32, importErr error) time.Duration {
+	interval := i.syncInterval(calendar)
+	delay := interval
+
+	for range failures - 1 {
+		if delay >= i.cfg.MaxBackoff {
+			break
+		}
+
+		delay *= 2
+	}
+
+	// The backoff never syncs a calendar more often than its interval, even if the interval is above the maximum
+	delay = max(min(delay, i.cfg.MaxBackoff), interval)
+
+	var statusCodeErr *StatusCodeError
+	if errors.As(importErr, &statusCodeErr) {
+		delay = max(delay, min(statusCodeErr.RetryAfter, i.cfg.MaxRetryAfter))
+	}
+
+	return delay
+}
@@ -0,0 +1,155 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	pbStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// RecordImport stores a run of the import of a calendar in its import history.
+func (r *Repository) RecordImport(ctx context.Context, record *pb.CalendarImport) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return err
+	}
+
+	var httpStatus *int32
+	if record.HttpStatus != 0 {
+		httpStatus = &record.HttpStatus
+	}
+
+	var errorPb []byte
+	if record.Error != nil {
+		errorPb, err = proto.Marshal(record.Error)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		insert into calendar_imports (id, calendar_id, start_time, end_time, http_status, bytes_fetched, unchanged,
+			events_added, events_updated, events_removed, alarms_scheduled, error_pb)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, id, record.CalendarId, record.StartTime.AsTime(), record.EndTime.AsTime(), httpStatus, record.BytesFetched,
+		record.Unchanged, record.EventsAdded, record.EventsUpdated, record.EventsRemoved, record.AlarmsScheduled,
+		errorPb)
+
+	return err
+}
+
+// ListImports returns the import history of the calendar from the newest to the oldest import, paginated by import
+// id. With changedOnly, imports of unchanged feeds and failed imports are skipped.
+func (r *Repository) ListImports(
+	ctx context.Context, calendarID string, pageSize int32, pageToken *pb.PageToken, changedOnly bool,
+) ([]*pb.CalendarImport, *pb.PageToken, error) {
+	var lastID *string
+	if pageToken.LastId != "" {
+		lastID = &pageToken.LastId
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		select id, calendar_id, start_time, end_time, http_status, bytes_fetched, unchanged, events_added,
+			events_updated, events_removed, alarms_scheduled, error_pb
+		from calendar_imports
+		where
+			calendar_id = $1 and
+			($3::uuid is null or id < $3) and
+			(not $4 or (not unchanged and error_pb is null))
+		order by id desc
+		limit $2
+	`, calendarID, pageSize, lastID, changedOnly)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rows.Close()
+
+	var imports []*pb.CalendarImport
+
+	for rows.Next() {
+		record, err := scanImport(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		imports = append(imports, record)
+	}
+
+	if rows.Err() != nil {
+		return nil, nil, rows.Err()
+	}
+
+	var nextPageToken *pb.PageToken
+
+	if int32(len(imports)) == pageSize {
+		nextPageToken = &pb.PageToken{
+			LastId: imports[len(imports)-1].Id,
+		}
+	}
+
+	return imports, nextPageToken, nil
+}
+
+// DeleteImportsBefore deletes the import history of all calendars that started before the given time.
+func (r *Repository) DeleteImportsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		delete from calendar_imports where start_time < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func scanImport(rows *sql.Rows) (*pb.CalendarImport, error) {
+	var (
+		record     = &pb.CalendarImport{}
+		startTime  time.Time
+		endTime    time.Time
+		httpStatus sql.Null[int32]
+		errorPb    sql.Null[[]byte]
+	)
+
+	err := rows.Scan(
+		&record.Id,
+		&record.CalendarId,
+		&startTime,
+		&endTime,
+		&httpStatus,
+		&record.BytesFetched,
+		&record.Unchanged,
+		&record.EventsAdded,
+		&record.EventsUpdated,
+		&record.EventsRemoved,
+		&record.AlarmsScheduled,
+		&errorPb,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	record.StartTime = timestamppb.New(startTime)
+	record.EndTime = timestamppb.New(endTime)
+	record.HttpStatus = httpStatus.V
+
+	if errorPb.Valid {
+		var status pbStatus.Status
+
+		err := proto.Unmarshal(errorPb.V, &status)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Error = &status
+	}
+
+	return record, nil
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"context"
+
+	"github.com/emersion/go-ical"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+// InlineDataStore returns the iCal data that was uploaded for calendars.
+type InlineDataStore interface {
+	GetInlineData(ctx context.Context, id string) ([]byte, error)
+}
+
+// InlineSource imports the iCal data that was uploaded with a calendar and is stored in the database.
+type InlineSource struct {
+	store      InlineDataStore
+	sizeLimits SizeLimits
+}
+
+func NewInlineSource(store InlineDataStore, sizeLimits SizeLimits) *InlineSource {
+	return &InlineSource{
+		store:      store,
+		sizeLimits: sizeLimits,
+	}
+}
+
+func (s *InlineSource) Fetch(ctx context.Context, calendar *pb.Calendar) (*ical.Calendar, SyncState, error) {
+	data, err := s.store.GetInlineData(ctx, calendar.Id)
+	if err != nil {
+		return nil, SyncState{}, err
+	}
+
+	return decodeFeed(calendar, data, SyncState{Hash: calendar.LastSyncHash}, s.sizeLimits.of(calendar))
+}
@@ -1,11 +1,93 @@
 package events
 
-import "context"
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
 
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/log"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+type OccurrenceRepository interface {
+	RecalculateAlarms(ctx context.Context, calendar *pb.Calendar, window timerange) error
+	DeleteAlarmsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OccurrenceCalculation keeps the alarms of all stored events materialized for a rolling time horizon, so recurring
+// events keep their alarms even if their feed doesn't change.
 type OccurrenceCalculation struct {
+	eventRepo    OccurrenceRepository
+	calendarRepo CalendarRepository
+	cfg          config.Occurrences
+	logger       *slog.Logger
+}
+
+func NewOccurrenceCalculation(
+	eventRepo OccurrenceRepository, calendarRepo CalendarRepository, cfg config.Occurrences,
+	logger *slog.Logger,
+) *OccurrenceCalculation {
+	return &OccurrenceCalculation{
+		eventRepo:    eventRepo,
+		calendarRepo: calendarRepo,
+		cfg:          cfg,
+		logger:       logger,
+	}
 }
 
 func (j *OccurrenceCalculation) Run(ctx context.Context) error {
+	now := time.Now()
+	window := timerange{from: now, to: now.Add(j.cfg.Horizon)}
+	nextPageToken := &pb.PageToken{}
+
+	for {
+		var (
+			calendars []*pb.Calendar
+			err       error
+		)
+
+		calendars, nextPageToken, err = j.calendarRepo.ListCalendars(ctx, j.cfg.PageSize, nextPageToken, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, cal := range calendars {
+			err := j.recalculate(ctx, cal, window)
+			if err != nil {
+				// Log, but don't return an error. One broken calendar must not keep the others from being updated
+				j.logger.ErrorContext(ctx, "failed to calculate occurrences",
+					slog.String("calendar_id", cal.Id),
+					log.Error(err),
+				)
+			}
+		}
+
+		if nextPageToken == nil {
+			break
+		}
+	}
+
+	deleted, err := j.eventRepo.DeleteAlarmsBefore(ctx, now.Add(-j.cfg.Retention))
+	if err != nil {
+		return err
+	}
+
+	j.logger.DebugContext(ctx, "deleted past alarms", slog.Int64("count", deleted))
 
 	return nil
 }
+
+// recalculate updates the alarms of the calendar, unless it is being imported right now. The import calculates the
+// alarms itself.
+func (j *OccurrenceCalculation) recalculate(ctx context.Context, calendar *pb.Calendar, window timerange) error {
+	err := j.eventRepo.RecalculateAlarms(ctx, calendar, window)
+	if errors.Is(err, ErrImportInProgress) {
+		j.logger.DebugContext(ctx, "calendar is being imported, skipping", slog.String("calendar_id", calendar.Id))
+
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var errRecalculation = errors.New("recalculation failed")
+
+// pagedCalendarRepository lists its calendars in pages, ordered like they are given.
+type pagedCalendarRepository struct {
+	fakeCalendarRepository
+
+	calendars []*pb.Calendar
+	pageSizes []int32
+}
+
+func (p *pagedCalendarRepository) ListCalendars(
+	_ context.Context, pageSize int32, pageToken *pb.PageToken, _ *pb.ListCalendarsFilter,
+) ([]*pb.Calendar, *pb.PageToken, error) {
+	p.pageSizes = append(p.pageSizes, pageSize)
+
+	start := 0
+
+	for i, calendar := range p.calendars {
+		if calendar.Id == pageToken.GetLastId() {
+			start = i + 1
+		}
+	}
+
+	end := min(start+int(pageSize), len(p.calendars))
+	page := p.calendars[start:end]
+
+	if end == len(p.calendars) {
+		return page, nil, nil
+	}
+
+	return page, &pb.PageToken{LastId: page[len(page)-1].Id}, nil
+}
+
+// fakeOccurrenceRepository records the windows that the alarms of calendars are recalculated for.
+type fakeOccurrenceRepository struct {
+	errs map[string]error
+
+	windows       map[string]timerange
+	deletedBefore time.Time
+}
+
+func (f *fakeOccurrenceRepository) RecalculateAlarms(_ context.Context, calendar *pb.Calendar, window timerange) error {
+	if f.windows == nil {
+		f.windows = make(map[string]timerange)
+	}
+
+	f.windows[calendar.Id] = window
+
+	return f.errs[calendar.Id]
+}
+
+func (f *fakeOccurrenceRepository) DeleteAlarmsBefore(_ context.Context, before time.Time) (int64, error) {
+	f.deletedBefore = before
+
+	return 0, nil
+}
+
+func TestOccurrenceCalculation_Run(t *testing.T) {
+	calendarRepo := &pagedCalendarRepository{
+		calendars: []*pb.Calendar{{Id: "calendar-1"}, {Id: "calendar-2"}, {Id: "calendar-3"}},
+	}
+	eventRepo := &fakeOccurrenceRepository{
+		errs: map[string]error{"calendar-1": errRecalculation, "calendar-2": ErrImportInProgress},
+	}
+	cfg := config.Occurrences{Horizon: 720 * time.Hour, Retention: 168 * time.Hour, PageSize: 2}
+
+	job := NewOccurrenceCalculation(eventRepo, calendarRepo, cfg, slog.New(slog.DiscardHandler))
+
+	before := time.Now()
+	require.NoError(t, job.Run(context.Background()))
+	after := time.Now()
+
+	require.Equal(t, []int32{2, 2}, calendarRepo.pageSizes)
+
+	// A failed calendar doesn't keep the others from being recalculated
+	require.Len(t, eventRepo.windows, 3)
+
+	window := eventRepo.windows["calendar-3"]
+	require.WithinRange(t, window.from, before, after)
+	require.Equal(t, cfg.Horizon, window.to.Sub(window.from))
+
+	for _, other := range eventRepo.windows {
+		require.Equal(t, window, other)
+	}
+
+	require.Equal(t, window.from.Add(-cfg.Retention), eventRepo.deletedBefore)
+}
@@ -0,0 +1,263 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const (
+	defaultPreviewAlarms = 5
+	maxPreviewAlarms     = 100
+)
+
+// Preview fetches and parses the feed of the calendar like an import, but returns its events with their next alarms
+// instead of storing them. The feed is always fetched, regardless of the sync state of the calendar. Only the
+// credentials passed with the calendar are sent, the stored credentials of a calendar with the same id never are.
+func (i *IcalImport) Preview(
+	ctx context.Context, calendar *pb.Calendar, alarmsPerEvent int,
+) (*pb.PreviewCalendarResponse, error) {
+	calendar, _ = proto.Clone(calendar).(*pb.Calendar)
+	calendar.Id = ""
+	calendar.HasCredentials = false
+	calendar.LastSyncHash = nil
+	calendar.HttpEtag = ""
+	calendar.HttpLastModified = ""
+
+	if caldav := calendar.GetCaldav(); caldav != nil {
+		caldav.SyncToken = ""
+		caldav.Ctag = ""
+	}
+
+	var (
+		icalCalendar *ical.Calendar
+		err          error
+	)
+
+	// Inline data of a preview comes with the request, it isn't stored yet
+	if inline := calendar.GetInline(); inline != nil {
+		icalCalendar, _, err = decodeFeed(
+			calendar, inline.Data, SyncState{}, i.sizeLimits().of(calendar),
+		)
+	} else {
+		var source Source
+
+		source, err = i.sources.of(calendar)
+		if err != nil {
+			return nil, err
+		}
+
+		icalCalendar, _, err = source.Fetch(ctx, calendar)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if alarmsPerEvent <= 0 {
+		alarmsPerEvent = defaultPreviewAlarms
+	}
+
+	now := time.Now()
+
+	resp, err := previewFeed(calendar, icalCalendar, i.ignoredAlarmActions, min(alarmsPerEvent, maxPreviewAlarms),
+		timerange{from: now, to: now.Add(i.horizon)})
+	if err != nil {
+		return nil, err
+	}
+
+	if i.cfg.MaxEvents > 0 && len(resp.Events) > i.cfg.MaxEvents {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("the feed has %d events, imports fail above %d events",
+			len(resp.Events), i.cfg.MaxEvents))
+	}
+
+	return resp, nil
+}
+
+// previewFeed returns the events of the feed that would be imported, with their first alarms within window. Events
+// that fail the import are reported as warnings, so all problems of a feed are visible at once.
+func previewFeed(
+	calendar *pb.Calendar, icalCalendar *ical.Calendar, ignoredActions []string, alarmsPerEvent int, window timerange,
+) (*pb.PreviewCalendarResponse, error) {
+	floating, err := floatingLocation(calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := newFeed(icalCalendar, floating)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	resp := &pb.PreviewCalendarResponse{}
+
+	for _, child := range icalCalendar.Children {
+		if child.Name != ical.CompEvent && child.Name != ical.CompTimezone {
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf("skipped %s component", child.Name))
+		}
+	}
+
+	for idx, event := range icalCalendar.Events() {
+		ev, ended, err := previewEvent(calendar, &event, feed, ignoredActions, alarmsPerEvent, window)
+		if err != nil {
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf("event %d fails the import: %v", idx+1, err))
+
+			continue
+		}
+
+		if ended {
+			resp.PastEvents++
+
+			continue
+		}
+
+		resp.Warnings = append(resp.Warnings, eventWarnings(&event, feed.zones, ignoredActions)...)
+		resp.Events = append(resp.Events, ev)
+	}
+
+	return resp, nil
+}
+
+// previewEvent converts the event and its first alarms. Like an import, it skips single events that ended already.
+func previewEvent(
+	calendar *pb.Calendar, event *ical.Event, feed *feed, ignoredActions []string, alarmsPerEvent int,
+	window timerange,
+) (*pb.PreviewEvent, bool, error) {
+	uid, recurrenceID, err := eventIdentity(event, feed.zones)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ended, err := eventEnded(event, feed.zones, window.from)
+	if err != nil || ended {
+		return nil, ended, err
+	}
+
+	alarms, err := calculateNextAlarms(calendar, "", event, feed, ignoredActions, window)
+	if err != nil {
+		return nil, false, err
+	}
+
+	start, err := feed.zones.EventStart(event)
+	if err != nil {
+		return nil, false, err
+	}
+
+	end, err := feed.zones.EventEnd(event)
+	if err != nil {
+		return nil, false, err
+	}
+
+	summary, err := event.Props.Text(ical.PropSummary)
+	if err != nil {
+		return nil, false, err
+	}
+
+	description, err := event.Props.Text(ical.PropDescription)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var categories []string
+
+	for _, prop := range event.Props.Values(ical.PropCategories) {
+		values, err := prop.TextList()
+		if err != nil {
+			return nil, false, err
+		}
+
+		categories = append(categories, values...)
+	}
+
+	startProp := event.Props.Get(ical.PropDateTimeStart)
+
+	result := &pb.PreviewEvent{
+		Uid: uid,
+		Event: &pb.Event{
+			Summary:     summary,
+			Description: description,
+			Categories:  categories,
+			StartTime:   timestamppb.New(start),
+			Duration:    durationpb.New(end.Sub(start)),
+		},
+		AllDay: startProp != nil && startProp.ValueType() == ical.ValueDate,
+		Recurring: event.Props.Get(ical.PropRecurrenceRule) != nil ||
+			event.Props.Get(ical.PropRecurrenceDates) != nil,
+	}
+
+	if recurrenceID != "" {
+		recurrenceTime, err := time.Parse(time.RFC3339, recurrenceID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		result.RecurrenceId = timestamppb.New(recurrenceTime)
+	}
+
+	// Alarms of the occurrences within the window may already have fired
+	alarms = slices.DeleteFunc(alarms, func(alarm EventAlarm) bool {
+		return alarm.AlarmTime.Before(window.from)
+	})
+	slices.SortFunc(alarms, func(a, b EventAlarm) int {
+		return a.AlarmTime.Compare(b.AlarmTime)
+	})
+
+	for _, alarm := range alarms[:min(len(alarms), alarmsPerEvent)] {
+		result.NextAlarms = append(result.NextAlarms, &pb.PreviewAlarm{
+			AlarmTime: timestamppb.New(alarm.AlarmTime),
+			EventTime: timestamppb.New(alarm.EventTime),
+		})
+	}
+
+	return result, false, nil
+}
+
+// eventWarnings describes the parts of the event that are ignored by the import.
+func eventWarnings(event *ical.Event, zones *timezone.Zones, ignoredActions []string) []string {
+	uid := event.Props.Get(ical.PropUID).Value
+
+	var warnings []string
+
+	// EXRULE was deprecated by RFC 5545
+	if event.Props.Get("EXRULE") != nil {
+		warnings = append(warnings, fmt.Sprintf("event %q: EXRULE is not supported", uid))
+	}
+
+	if len(event.Props.Values(ical.PropRecurrenceRule)) > 1 {
+		warnings = append(warnings, fmt.Sprintf("event %q: only the first RRULE is used", uid))
+	}
+
+	for _, component := range event.Children {
+		if component.Name != ical.CompAlarm {
+			continue
+		}
+
+		action, err := component.Props.Text(ical.PropAction)
+
+		switch {
+		case err != nil:
+			warnings = append(warnings, fmt.Sprintf("event %q: skipped alarm with invalid ACTION", uid))
+		case slices.ContainsFunc(ignoredActions, func(ignored string) bool {
+			return strings.EqualFold(ignored, action)
+		}):
+			warnings = append(warnings, fmt.Sprintf("event %q: ignored alarm with action %s", uid, action))
+		default:
+			_, ok := parseTrigger(component.Props.Get(ical.PropTrigger), zones)
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("event %q: skipped alarm with invalid TRIGGER", uid))
+			}
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,143 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const previewFeedData = `BEGIN:VCALENDAR
+PRODID:test
+VERSION:2.0
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20250310T090000Z
+DTEND:20250310T091500Z
+RRULE:FREQ=DAILY;COUNT=5
+SUMMARY:Standup
+BEGIN:VALARM
+ACTION:DISPLAY
+TRIGGER:-PT10M
+END:VALARM
+BEGIN:VALARM
+ACTION:EMAIL
+TRIGGER:-PT1H
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:retro@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20250201T090000Z
+DTEND:20250201T100000Z
+SUMMARY:Retro
+END:VEVENT
+BEGIN:VEVENT
+DTSTAMP:20250101T000000Z
+DTSTART:20250312T090000Z
+SUMMARY:No UID
+END:VEVENT
+BEGIN:VTODO
+UID:todo@example.com
+DTSTAMP:20250101T000000Z
+SUMMARY:Prepare slides
+END:VTODO
+END:VCALENDAR
+`
+
+func TestPreviewFeed(t *testing.T) {
+	icalCalendar, err := ical.NewDecoder(
+		bytes.NewReader([]byte(strings.ReplaceAll(previewFeedData, "\n", "\r\n"))),
+	).Decode()
+	require.NoError(t, err)
+
+	calendar := &pb.Calendar{DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_UNSET_ONLY}
+	window := timerange{
+		from: time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	resp, err := previewFeed(calendar, icalCalendar, []string{"EMAIL"}, 2, window)
+	require.NoError(t, err)
+
+	require.Len(t, resp.Events, 1)
+	require.EqualValues(t, 1, resp.PastEvents)
+
+	standup := resp.Events[0]
+	require.Equal(t, "standup@example.com", standup.Uid)
+	require.Equal(t, "Standup", standup.Event.Summary)
+	require.Equal(t, 15*time.Minute, standup.Event.Duration.AsDuration())
+	require.True(t, standup.Recurring)
+	require.False(t, standup.AllDay)
+
+	// The occurrence on March 10th is before the window, only the first two of the remaining ones are returned
+	require.Len(t, standup.NextAlarms, 2)
+	require.Equal(t, time.Date(2025, 3, 11, 8, 50, 0, 0, time.UTC), standup.NextAlarms[0].AlarmTime.AsTime())
+	require.Equal(t, time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC), standup.NextAlarms[0].EventTime.AsTime())
+	require.Equal(t, time.Date(2025, 3, 12, 8, 50, 0, 0, time.UTC), standup.NextAlarms[1].AlarmTime.AsTime())
+
+	require.ElementsMatch(t, []string{
+		"skipped VTODO component",
+		`event "standup@example.com": ignored alarm with action EMAIL`,
+		"event 3 fails the import: event has no UID",
+	}, resp.Warnings)
+}
+
+func TestIcalImport_PreviewCredentials(t *testing.T) {
+	testcases := []struct {
+		Name string
+
+		Credentials *pb.FeedCredentials
+
+		ExpectedAuthorization string
+	}{{
+		Name: "Stored credentials",
+	}, {
+		Name:                  "Passed credentials",
+		Credentials:           &pb.FeedCredentials{Authorization: &pb.FeedCredentials_BearerToken{BearerToken: "mine"}},
+		ExpectedAuthorization: "Bearer mine",
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var headers http.Header
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header
+				_, _ = io.WriteString(w, testFeed)
+			}))
+			defer server.Close()
+
+			calendarRepo := &fakeCalendarRepository{
+				credentials: &pb.FeedCredentials{Authorization: &pb.FeedCredentials_BearerToken{BearerToken: "victim"}},
+			}
+			importer := NewIcalImport(
+				&unusedEventRepository{t: t}, calendarRepo,
+				Sources{HTTP: NewHTTPSource(server.Client(), calendarRepo, testSizeLimits)}, nil, time.Hour,
+				config.Imports{}, slog.New(slog.DiscardHandler),
+			)
+
+			_, err := importer.Preview(context.Background(), &pb.Calendar{
+				Id:             "calendar-of-someone-else",
+				HasCredentials: true,
+				Credentials:    testcase.Credentials,
+				Source:         &pb.Calendar_Http{Http: &pb.HTTPSource{Url: server.URL}},
+			}, 0)
+			require.NoError(t, err)
+			require.NotNil(t, headers)
+			require.Equal(t, testcase.ExpectedAuthorization, headers.Get("Authorization"))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package events
+
+import (
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/teambition/rrule-go"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const statusCancelled = "CANCELLED"
+
+// feed holds what is needed to interpret a single event of an iCal feed: the time zones of the feed, and the
+// overrides of occurrences of its recurring events.
+type feed struct {
+	zones     *timezone.Zones
+	overrides seriesOverrides
+}
+
+func newFeed(cal *ical.Calendar, floating *time.Location) (*feed, error) {
+	zones := timezone.New(cal, floating)
+
+	overrides, err := newSeriesOverrides(cal.Events(), zones)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feed{zones: zones, overrides: overrides}, nil
+}
+
+// floatingLocation returns the location of floating times and dates in the calendar's feed.
+func floatingLocation(calendar *pb.Calendar) (*time.Location, error) {
+	if calendar.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(calendar.TimeZone)
+}
+
+// seriesOverrides maps the UID of a recurring event to the RECURRENCE-IDs of its occurrences that are replaced by a
+// separate component with the same UID. Those occurrences are moved, changed or cancelled and must not be expanded
+// from the recurring event itself.
+type seriesOverrides map[string][]time.Time
+
+func newSeriesOverrides(events []ical.Event, zones *timezone.Zones) (seriesOverrides, error) {
+	overrides := make(seriesOverrides)
+
+	for _, event := range events {
+		recurrenceIDProp := event.Props.Get(ical.PropRecurrenceID)
+		if recurrenceIDProp == nil {
+			continue
+		}
+
+		uid, err := event.Props.Text(ical.PropUID)
+		if err != nil {
+			return nil, err
+		}
+
+		recurrenceID, err := zones.DateTime(recurrenceIDProp)
+		if err != nil {
+			return nil, err
+		}
+
+		overrides[uid] = append(overrides[uid], recurrenceID)
+	}
+
+	return overrides, nil
+}
+
+// of returns the overridden occurrences of the event. Only the recurring event itself can have overrides, the
+// overriding components are single occurrences.
+func (o seriesOverrides) of(event *ical.Event) []time.Time {
+	if event.Props.Get(ical.PropRecurrenceID) != nil {
+		return nil
+	}
+
+	uid, err := event.Props.Text(ical.PropUID)
+	if err != nil {
+		return nil
+	}
+
+	return o[uid]
+}
+
+// isCancelled reports whether the event, or the single occurrence it overrides, is cancelled.
+func isCancelled(event *ical.Event) bool {
+	prop := event.Props.Get(ical.PropStatus)
+
+	return prop != nil && strings.EqualFold(prop.Value, statusCancelled)
+}
+
+// recurrenceSet returns the occurrences of a recurring event, or nil if the event doesn't recur. Occurrences listed
+// in EXDATE or overridden by other components of the feed are excluded. The recurrence is expanded in the time zone
+// of the event's start, so occurrences keep their local time across DST changes. Unlike ical.Event.RecurrenceSet,
+// this supports value lists, RDATE periods and RDATE without RRULE.
+func (f *feed) recurrenceSet(event *ical.Event) (*rrule.Set, error) {
+	ruleOptions, err := event.Props.RecurrenceRule()
+	if err != nil {
+		return nil, err
+	}
+
+	rdates, err := f.zones.DateTimes(event.Props, ical.PropRecurrenceDates)
+	if err != nil {
+		return nil, err
+	}
+
+	if ruleOptions == nil && len(rdates) == 0 {
+		return nil, nil
+	}
+
+	exdates, err := f.zones.DateTimes(event.Props, ical.PropExceptionDates)
+	if err != nil {
+		return nil, err
+	}
+
+	dtstart, err := f.zones.EventStart(event)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &rrule.Set{}
+	set.DTStart(dtstart)
+
+	if ruleOptions != nil {
+		rule, err := rrule.NewRRule(*ruleOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		set.RRule(rule)
+	} else {
+		// The start of an event is always its first occurrence, RDATEs only add further occurrences
+		set.RDate(dtstart)
+	}
+
+	set.SetRDates(append(set.GetRDate(), rdates...))
+	set.SetExDates(append(exdates, f.overrides.of(event)...))
+
+	return set, nil
+}
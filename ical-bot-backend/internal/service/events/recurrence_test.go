@@ -0,0 +1,197 @@
+package events
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const seriesFeed = `BEGIN:VCALENDAR
+PRODID:test
+VERSION:2.0
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20250303T090000Z
+DTEND:20250303T091500Z
+RRULE:FREQ=DAILY;COUNT=7
+EXDATE:20250304T090000Z,20250305T090000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+RECURRENCE-ID:20250306T090000Z
+DTSTART:20250306T130000Z
+DTEND:20250306T131500Z
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+RECURRENCE-ID:20250307T090000Z
+DTSTART:20250307T090000Z
+DTEND:20250307T091500Z
+STATUS:CANCELLED
+END:VEVENT
+BEGIN:VEVENT
+UID:retro@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20250303T150000Z
+DTEND:20250303T160000Z
+RDATE;VALUE=PERIOD:20250305T150000Z/20250305T160000Z
+STATUS:CANCELLED
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestCalculateNextAlarms_Series(t *testing.T) {
+	calendar := &pb.Calendar{
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+		DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(10 * time.Minute)}},
+	}
+
+	window := timerange{
+		from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	cal, err := ical.NewDecoder(strings.NewReader(strings.ReplaceAll(seriesFeed, "\n", "\r\n"))).Decode()
+	require.NoError(t, err)
+
+	events := cal.Events()
+
+	feed, err := newFeed(cal, time.UTC)
+	require.NoError(t, err)
+
+	var eventTimes []time.Time
+
+	for i := range events {
+		alarms, err := calculateNextAlarms(calendar, "event", &events[i], feed, nil, window)
+		require.NoError(t, err)
+
+		for _, alarm := range alarms {
+			eventTimes = append(eventTimes, alarm.EventTime.UTC())
+		}
+	}
+
+	sort.Slice(eventTimes, func(i, j int) bool {
+		return eventTimes[i].Before(eventTimes[j])
+	})
+
+	require.Equal(t, []time.Time{
+		time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 6, 13, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 9, 9, 0, 0, 0, time.UTC),
+	}, eventTimes)
+}
+
+func TestRecurrenceSet_RDatesWithoutRule(t *testing.T) {
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, "retro@example.com")
+
+	start := ical.NewProp(ical.PropDateTimeStart)
+	start.Value = "20250303T150000Z"
+	event.Props.Set(start)
+
+	rdate := ical.NewProp(ical.PropRecurrenceDates)
+	rdate.Value = "20250305T150000Z,20250307T150000Z"
+	event.Props.Set(rdate)
+
+	feed := &feed{
+		zones:     timezone.New(ical.NewCalendar(), nil),
+		overrides: seriesOverrides{"retro@example.com": {time.Date(2025, 3, 5, 15, 0, 0, 0, time.UTC)}},
+	}
+
+	set, err := feed.recurrenceSet(event)
+	require.NoError(t, err)
+	require.NotNil(t, set)
+
+	var occurrences []time.Time
+	for _, occurrence := range set.All() {
+		occurrences = append(occurrences, occurrence.UTC())
+	}
+
+	require.Equal(t, []time.Time{
+		time.Date(2025, 3, 3, 15, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 7, 15, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func TestCalculateNextAlarms_TimeZones(t *testing.T) {
+	calendar := &pb.Calendar{
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+		DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(0)}},
+		TimeZone:            "America/New_York",
+	}
+
+	window := timerange{
+		from: time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		Name string
+
+		Props  map[string]string
+		Params map[string]string
+
+		ExpectedEventTimes []time.Time
+	}{{
+		Name: "Weekly meeting keeps its local time across DST",
+		Props: map[string]string{
+			ical.PropDateTimeStart:  "20250106T090000",
+			ical.PropRecurrenceRule: "FREQ=WEEKLY",
+		},
+		Params: map[string]string{ical.PropTimezoneID: "Europe/Berlin"},
+		ExpectedEventTimes: []time.Time{
+			time.Date(2025, 3, 24, 8, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 31, 7, 0, 0, 0, time.UTC),
+		},
+	}, {
+		Name: "Floating times use the calendar's time zone",
+		Props: map[string]string{
+			ical.PropDateTimeStart: "20250401T090000",
+		},
+		ExpectedEventTimes: []time.Time{time.Date(2025, 4, 1, 13, 0, 0, 0, time.UTC)},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			event := ical.NewEvent()
+			for name, value := range testcase.Props {
+				prop := ical.NewProp(name)
+				prop.Value = value
+
+				if name == ical.PropDateTimeStart {
+					for param, paramValue := range testcase.Params {
+						prop.Params.Set(param, paramValue)
+					}
+				}
+
+				event.Props.Set(prop)
+			}
+
+			floating, err := floatingLocation(calendar)
+			require.NoError(t, err)
+
+			feed, err := newFeed(ical.NewCalendar(), floating)
+			require.NoError(t, err)
+
+			alarms, err := calculateNextAlarms(calendar, "event-1", event, feed, nil, window)
+			require.NoError(t, err)
+			require.Len(t, alarms, len(testcase.ExpectedEventTimes))
+
+			for i, alarm := range alarms {
+				require.True(t, testcase.ExpectedEventTimes[i].Equal(alarm.EventTime), "occurrence %d: %s", i, alarm.EventTime)
+			}
+		})
+	}
+}
@@ -4,20 +4,30 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/emersion/go-ical"
 	"github.com/google/uuid"
 
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
 	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
 )
 
+var ErrMissingUID = errors.New("event has no UID")
+
+// timerange is the half-open interval [from, to).
 type timerange struct {
 	from time.Time
 	to   time.Time
 }
 
+func (t timerange) contains(v time.Time) bool {
+	return !v.Before(t.from) && v.Before(t.to)
+}
+
 func (t timerange) PostgresString() string {
 	return fmt.Sprintf("['%s','%s')", t.from.Format(time.RFC3339Nano), t.to.Format(time.RFC3339Nano))
 }
@@ -31,40 +41,106 @@ type EventAlarm struct {
 
 type Repository struct {
 	db *sql.DB
+
+	// ignoredAlarmActions are the VALARM actions, like EMAIL, that don't create alarms
+	ignoredAlarmActions []string
 }
 
-func NewRepository(db *sql.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *sql.DB, ignoredAlarmActions []string) *Repository {
+	return &Repository{db: db, ignoredAlarmActions: ignoredAlarmActions}
+}
+
+// SyncState identifies the version of a feed, so unchanged feeds can be skipped on the next sync.
+type SyncState struct {
+	// Hash is the SHA-256 hash of the fetched iCal file
+	Hash []byte
+	// ETag and LastModified are the cache validators sent by the feed host
+	ETag         string
+	LastModified string
+	// SyncToken and CTag are the versions of a CalDAV collection
+	SyncToken string
+	CTag      string
+	// CalDAVChanges are the changes to the stored calendar objects of a CalDAV collection, they match SyncToken
+	CalDAVChanges CalDAVChanges
+
+	// HTTPStatus and Size describe the fetch of the feed for the import history, they aren't needed for the next sync
+	HTTPStatus int
+	Size       int64
+
+	// NextSyncTime is when the calendar is synced again
+	NextSyncTime time.Time
 }
 
 type Import struct {
 	calendarID string
+	syncState  SyncState
+
+	// eventIDs contains the events that are part of the imported feed, all others are removed when closing
+	eventIDs []string
+	// eventsAdded, eventsUpdated, eventsRemoved and alarmsScheduled are the changes made by the import
+	eventsAdded     int
+	eventsUpdated   int
+	eventsRemoved   int
+	alarmsScheduled int
+
+	ignoredAlarmActions []string
 
 	tx *sql.Tx
 }
 
-func (r *Repository) StartImport(ctx context.Context, calendarID string) (*Import, error) {
-	tx, err := r.db.BeginTx(ctx, nil)
+// StartImport begins the import of the events of a feed. Its transaction holds the lock of the calendar, so
+// ErrImportInProgress is returned if another import or recalculation of the calendar's alarms holds it.
+func (r *Repository) StartImport(ctx context.Context, calendarID string, syncState SyncState) (*Import, error) {
+	tx, err := beginLocked(ctx, r.db, calendarID)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = tx.ExecContext(ctx, `delete from calendar_events where calendar_id = $1`, calendarID)
+	return &Import{
+		tx:         tx,
+		calendarID: calendarID,
+		syncState:  syncState,
+		// A nil slice is sent as NULL, which would keep all events of a feed that became empty
+		eventIDs:            []string{},
+		ignoredAlarmActions: r.ignoredAlarmActions,
+	}, nil
+}
+
+func (i *Import) Close(err error) error {
 	if err != nil {
-		_ = tx.Rollback()
+		return i.tx.Rollback()
+	}
 
-		return nil, err
+	removed, err := i.tx.Exec(`
+		DELETE FROM calendar_events
+		WHERE calendar_id = $1 AND NOT (id = any($2::uuid[]))
+	`, i.calendarID, i.eventIDs)
+	if err != nil {
+		_ = i.tx.Rollback()
+		return err
 	}
 
-	return &Import{tx: tx, calendarID: calendarID}, nil
-}
+	eventsRemoved, err := removed.RowsAffected()
+	if err != nil {
+		_ = i.tx.Rollback()
+		return err
+	}
 
-func (i *Import) Close(err error) error {
+	i.eventsRemoved = int(eventsRemoved)
+
+	err = i.storeCalDAVChanges()
 	if err != nil {
-		return i.tx.Rollback()
+		_ = i.tx.Rollback()
+		return err
 	}
 
-	_, err = i.tx.Exec(`UPDATE calendars SET last_sync_time = $1 WHERE id = $2`, time.Now(), i.calendarID)
+	_, err = i.tx.Exec(`
+		UPDATE calendars
+		SET last_sync_time = $1, last_sync_hash = $2, http_etag = $3, http_last_modified = $4, sync_error_pb = NULL,
+			caldav_sync_token = nullif($6, ''), caldav_ctag = nullif($7, ''), next_sync_time = $8, sync_failures = 0
+		WHERE id = $5
+	`, time.Now(), i.syncState.Hash, i.syncState.ETag, i.syncState.LastModified, i.calendarID,
+		i.syncState.SyncToken, i.syncState.CTag, i.syncState.NextSyncTime)
 	if err != nil {
 		_ = i.tx.Rollback()
 		return err
@@ -73,45 +149,376 @@ func (i *Import) Close(err error) error {
 	return i.tx.Commit()
 }
 
-func (i *Import) CreateEvent(ctx context.Context, calendar *pb.Calendar, event *ical.Event) error {
-	eventID := uuid.New().String()
+// storeCalDAVChanges applies the changes of the calendar objects of a CalDAV collection, so they match the
+// sync-token that is stored with them.
+func (i *Import) storeCalDAVChanges() error {
+	changes := i.syncState.CalDAVChanges
 
-	data, err := encodeEvent(eventID, event)
+	hrefs := make([]string, 0, len(changes.Changed))
+	data := make([]string, 0, len(changes.Changed))
+
+	for href, object := range changes.Changed {
+		hrefs = append(hrefs, href)
+		data = append(data, object)
+	}
+
+	if len(changes.Removed) > 0 {
+		_, err := i.tx.Exec(`
+			delete from calendar_caldav_objects where calendar_id = $1 and href = any($2::text[])
+		`, i.calendarID, changes.Removed)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(hrefs) == 0 {
+		return nil
+	}
+
+	_, err := i.tx.Exec(`
+		insert into calendar_caldav_objects (calendar_id, href, data)
+		select $1, o.href, o.data
+		from unnest($2::text[], $3::text[]) as o(href, data)
+		on conflict (calendar_id, href) do update set data = excluded.data
+	`, i.calendarID, hrefs, data)
+
+	return err
+}
+
+// GetCalDAVObjects returns the calendar objects of the CalDAV collection of the calendar as of its last import, as
+// data by href.
+func (r *Repository) GetCalDAVObjects(ctx context.Context, calendarID string) (map[string]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select href, data from calendar_caldav_objects where calendar_id = $1
+	`, calendarID)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer rows.Close()
+
+	objects := make(map[string]string)
+
+	for rows.Next() {
+		var href, data string
+
+		err := rows.Scan(&href, &data)
+		if err != nil {
+			return nil, err
+		}
 
-	alarms, err := calculateNextAlarms(calendar, eventID, event)
+		objects[href] = data
+	}
+
+	return objects, rows.Err()
+}
+
+// UpsertEvent stores the event identified by its UID and RECURRENCE-ID, and materializes the alarms of its
+// occurrences within window. An event that was imported before keeps its ID, as do its alarms whose times didn't
+// change, so their delivery state survives the import.
+func (i *Import) UpsertEvent(
+	ctx context.Context, calendar *pb.Calendar, event *ical.Event, feed *feed, window timerange,
+) error {
+	uid, recurrenceID, err := eventIdentity(event, feed.zones)
 	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	var (
+		eventID string
+		isNew   bool
+	)
+
+	err = i.tx.QueryRowContext(ctx, `
+		select id from calendar_events
+		where calendar_id = $1 and uid = $2 and recurrence_id = $3
+	`, i.calendarID, uid, recurrenceID).Scan(&eventID)
+	if errors.Is(err, sql.ErrNoRows) {
+		eventID = uuid.New().String()
+		isNew = true
+	} else if err != nil {
 		return err
 	}
 
-	if len(alarms) == 0 {
+	data, err := encodeEvent(eventID, event, feed.zones.Definitions(event.Component))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	ended, err := eventEnded(event, feed.zones, window.from)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	if ended {
 		return nil
 	}
 
-	_, err = i.tx.ExecContext(ctx, `
-		insert into calendar_events (id, calendar_id, data) values ($1, $2, $3);
-	`, eventID, i.calendarID, data)
+	alarms, err := calculateNextAlarms(calendar, eventID, event, feed, i.ignoredAlarmActions, window)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	// The overrides are kept with the recurring event, the overriding events are dropped once they ended
+	overriddenOccurrences := append([]time.Time{}, feed.overrides.of(event)...)
+
+	upserted, err := i.tx.ExecContext(ctx, `
+		insert into calendar_events (id, calendar_id, uid, recurrence_id, data, overridden_occurrences)
+		values ($1, $2, $3, $4, $5, $6)
+		on conflict (calendar_id, uid, recurrence_id) do update
+		set data = excluded.data, overridden_occurrences = excluded.overridden_occurrences
+		where (calendar_events.data, calendar_events.overridden_occurrences)
+			is distinct from (excluded.data, excluded.overridden_occurrences)
+	`, eventID, i.calendarID, uid, recurrenceID, data, overriddenOccurrences)
+	if err != nil {
+		return err
+	}
 
+	// Events whose data didn't change aren't written again
+	changed, err := upserted.RowsAffected()
 	if err != nil {
 		return err
 	}
 
-	for _, alarm := range alarms {
-		_, err := i.tx.ExecContext(ctx, `
-			insert into calendar_event_alarms (event_id, alarm_time, event_time)
-			values ($1, $2, $3)
-		`, alarm.EventID, alarm.AlarmTime, alarm.EventTime)
+	switch {
+	case isNew:
+		i.eventsAdded++
+	case changed > 0:
+		i.eventsUpdated++
+	}
+
+	i.eventIDs = append(i.eventIDs, eventID)
+	i.alarmsScheduled += len(alarms)
+
+	return replaceAlarms(ctx, i.tx, eventID, alarms, window)
+}
+
+// Result returns the changes made by the import. Removed events are only known after it was closed.
+func (i *Import) Result() ImportResult {
+	return ImportResult{
+		EventsImported:  len(i.eventIDs),
+		EventsAdded:     i.eventsAdded,
+		EventsUpdated:   i.eventsUpdated,
+		EventsRemoved:   i.eventsRemoved,
+		AlarmsScheduled: i.alarmsScheduled,
+	}
+}
+
+// RecalculateAlarms materializes the alarms of all stored events of the calendar for their occurrences within
+// window, independent of imports. ErrImportInProgress is returned if the calendar is being imported.
+func (r *Repository) RecalculateAlarms(ctx context.Context, calendar *pb.Calendar, window timerange) error {
+	floating, err := floatingLocation(calendar)
+	if err != nil {
+		return err
+	}
+
+	tx, err := beginLocked(ctx, r.db, calendar.Id)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		select id, data from calendar_events where calendar_id = $1
+	`, calendar.Id)
+	if err != nil {
+		return err
+	}
+
+	var (
+		eventIDs []string
+		// stored collects the stored events with their time zones, so they can be interpreted like the whole feed
+		stored = ical.NewCalendar()
+	)
+
+	for rows.Next() {
+		var (
+			id   string
+			data []byte
+		)
+
+		err := rows.Scan(&id, &data)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		cal, err := ical.NewDecoder(bytes.NewReader(data)).Decode()
+		if err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("decoding event %s: %w", id, err)
+		}
+
+		if len(cal.Events()) != 1 {
+			_ = rows.Close()
+			return fmt.Errorf("decoding event %s: expected a single event, got %d", id, len(cal.Events()))
+		}
+
+		eventIDs = append(eventIDs, id)
+		stored.Children = append(stored.Children, cal.Children...)
+	}
+
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	feed, err := newFeed(stored, floating)
+	if err != nil {
+		return err
+	}
+
+	err = addStoredOverrides(ctx, tx, calendar.Id, feed.overrides)
+	if err != nil {
+		return err
+	}
+
+	for idx, event := range stored.Events() {
+		id := eventIDs[idx]
+
+		alarms, err := calculateNextAlarms(calendar, id, &event, feed, r.ignoredAlarmActions, window)
+		if err != nil {
+			return fmt.Errorf("calculating alarms of event %s: %w", id, err)
+		}
+
+		err = replaceAlarms(ctx, tx, id, alarms, window)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// addStoredOverrides adds the overridden occurrences stored with the recurring events of the calendar. The events
+// overriding them aren't stored anymore once they ended, even if they moved an occurrence that is still to come.
+func addStoredOverrides(ctx context.Context, tx *sql.Tx, calendarID string, overrides seriesOverrides) error {
+	rows, err := tx.QueryContext(ctx, `
+		select uid, unnest(overridden_occurrences) from calendar_events where calendar_id = $1
+	`, calendarID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			uid          string
+			recurrenceID time.Time
+		)
+
+		err := rows.Scan(&uid, &recurrenceID)
 		if err != nil {
 			return err
 		}
+
+		overrides[uid] = append(overrides[uid], recurrenceID)
+	}
+
+	return rows.Err()
+}
+
+// beginLocked begins a transaction that holds the lock of the calendar, or returns ErrImportInProgress if it is held
+// by another transaction. Taking the lock on the transaction that writes the events needs no other connection.
+func beginLocked(ctx context.Context, db *sql.DB, calendarID string) (*sql.Tx, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	acquired, err := database.TryLockTx(ctx, tx, calendarLockKey(calendarID))
+	if err != nil {
+		_ = tx.Rollback()
+
+		return nil, fmt.Errorf("acquiring calendar lock: %w", err)
+	}
+
+	if !acquired {
+		_ = tx.Rollback()
+
+		return nil, ErrImportInProgress
+	}
+
+	return tx, nil
+}
+
+// DeleteAlarmsBefore deletes the alarms of occurrences that started before the given time, together with their
+// delivery state.
+func (r *Repository) DeleteAlarmsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		delete from calendar_event_alarms where event_time < $1
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// replaceAlarms makes alarms the set of alarms of the event that fire from the start of window on, keeping the rows
+// of alarms that already exist. Alarms that fired earlier are left alone, so their pending deliveries aren't lost.
+func replaceAlarms(ctx context.Context, tx *sql.Tx, eventID string, alarms []EventAlarm, window timerange) error {
+	alarmTimes := make([]time.Time, 0, len(alarms))
+	eventTimes := make([]time.Time, 0, len(alarms))
+
+	for _, alarm := range alarms {
+		alarmTimes = append(alarmTimes, alarm.AlarmTime)
+		eventTimes = append(eventTimes, alarm.EventTime)
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		delete from calendar_event_alarms a
+		where
+			a.event_id = $1 and
+			a.alarm_time >= $4 and
+			not exists (
+				select 1
+				from unnest($2::timestamptz[], $3::timestamptz[]) as n(alarm_time, event_time)
+				where n.alarm_time = a.alarm_time and n.event_time = a.event_time
+			)
+	`, eventID, alarmTimes, eventTimes, window.from)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into calendar_event_alarms (event_id, alarm_time, event_time)
+		select $1, n.alarm_time, n.event_time
+		from unnest($2::timestamptz[], $3::timestamptz[]) as n(alarm_time, event_time)
+		on conflict (event_id, alarm_time, event_time) do nothing
+	`, eventID, alarmTimes, eventTimes)
+
+	return err
+}
+
+// eventIdentity returns the UID and the normalized RECURRENCE-ID of the event, which together identify it within
+// its calendar.
+func eventIdentity(event *ical.Event, zones *timezone.Zones) (string, string, error) {
+	uid, err := event.Props.Text(ical.PropUID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if uid == "" {
+		return "", "", ErrMissingUID
+	}
+
+	recurrenceIDProp := event.Props.Get(ical.PropRecurrenceID)
+	if recurrenceIDProp == nil {
+		return uid, "", nil
+	}
+
+	recurrenceID, err := zones.DateTime(recurrenceIDProp)
+	if err != nil {
+		return "", "", err
 	}
 
-	return nil
+	return uid, recurrenceID.UTC().Format(time.RFC3339), nil
 }
 
-func encodeEvent(id string, event *ical.Event) ([]byte, error) {
+// encodeEvent encodes the event as a calendar of its own, together with the definitions of the time zones it uses.
+func encodeEvent(id string, event *ical.Event, timeZones []*ical.Component) ([]byte, error) {
 	idProp := ical.NewProp(ical.PropProductID)
 	idProp.SetText(id)
 
@@ -121,6 +528,7 @@ func encodeEvent(id string, event *ical.Event) ([]byte, error) {
 	calendar := ical.NewCalendar()
 	calendar.Props.Add(idProp)
 	calendar.Props.Add(versionProp)
+	calendar.Children = append(calendar.Children, timeZones...)
 	calendar.Children = append(calendar.Children, event.Component)
 
 	var buf bytes.Buffer
@@ -132,115 +540,122 @@ func encodeEvent(id string, event *ical.Event) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func calculateNextAlarms(calendar *pb.Calendar, eventID string, event *ical.Event) ([]EventAlarm, error) {
-	eventStart, err := event.DateTimeStart(time.UTC)
+// eventEnded reports whether the event is a single, non-recurring event that ended before now. Such an event can't
+// have any future alarms, so it doesn't need to be stored.
+func eventEnded(event *ical.Event, zones *timezone.Zones, now time.Time) (bool, error) {
+	if event.Props.Get(ical.PropRecurrenceRule) != nil || event.Props.Get(ical.PropRecurrenceDates) != nil {
+		return false, nil
+	}
+
+	eventEnd, err := zones.EventEnd(event)
+	if err != nil {
+		return false, err
+	}
+
+	return eventEnd.Before(now), nil
+}
+
+// calculateNextAlarms returns the alarms of the event's occurrences that fire within window. Occurrences that are
+// overridden by another component of the feed are skipped, that component provides their alarms instead.
+func calculateNextAlarms(
+	calendar *pb.Calendar, eventID string, event *ical.Event, feed *feed, ignoredActions []string, window timerange,
+) ([]EventAlarm, error) {
+	if isCancelled(event) {
+		return nil, nil
+	}
+
+	eventStart, err := feed.zones.EventStart(event)
 	if err != nil {
 		return nil, err
 	}
 
-	eventEnd, err := event.DateTimeEnd(time.UTC)
+	eventEnd, err := feed.zones.EventEnd(event)
 	if err != nil {
 		return nil, err
 	}
 
-	var alarms []time.Duration
+	startProp := event.Props.Get(ical.PropDateTimeStart)
+	allDay := startProp != nil && startProp.ValueType() == ical.ValueDate
+
+	var triggers []alarmTrigger
 
 	switch calendar.DefaultReminderMode {
 	case pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_UNSET_ONLY:
-		alarms = getAlarms(event)
-		if len(alarms) == 0 {
-			for _, defaultReminder := range calendar.DefaultReminders {
-				alarms = append(alarms, defaultReminder.Before.AsDuration())
-			}
+		triggers = eventTriggers(event, feed.zones, ignoredActions)
+		if len(triggers) == 0 {
+			triggers = defaultTriggers(calendar, allDay)
 		}
 	case pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_ADD:
-		alarms = getAlarms(event)
-
-		for _, defaultReminder := range calendar.DefaultReminders {
-			alarms = append(alarms, defaultReminder.Before.AsDuration())
-		}
+		triggers = append(eventTriggers(event, feed.zones, ignoredActions), defaultTriggers(calendar, allDay)...)
 	case pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE:
-		for _, defaultReminder := range calendar.DefaultReminders {
-			alarms = append(alarms, defaultReminder.Before.AsDuration())
-		}
+		triggers = defaultTriggers(calendar, allDay)
 	}
 
-	recurrenceSet, err := event.RecurrenceSet(time.UTC)
+	recurrenceSet, err := feed.recurrenceSet(event)
 	if err != nil {
 		return nil, err
 	}
 
-	if recurrenceSet == nil {
-		if eventEnd.Before(time.Now()) {
-			return nil, nil
-		}
+	duration := eventEnd.Sub(eventStart)
 
-		nextAlarms := make([]EventAlarm, 0, len(alarms))
+	// Alarms fire before or after the start of their occurrence, so occurrences are searched in a range that covers
+	// the offsets of all triggers. Their alarms are filtered by the time they fire.
+	occurrenceWindow := window
 
-		for _, alarm := range alarms {
-			nextAlarms = append(nextAlarms, EventAlarm{
-				AlarmTime: eventStart.Add(-alarm),
-				EventTime: eventStart,
-				EventID:   eventID,
-				ID:        uuid.New().String(),
-			})
+	for _, trigger := range triggers {
+		earliest, latest := trigger.span(eventStart, duration)
+
+		if from := window.from.Add(-latest); from.Before(occurrenceWindow.from) {
+			occurrenceWindow.from = from
 		}
 
-		return nextAlarms, nil
+		if to := window.to.Add(-earliest); to.After(occurrenceWindow.to) {
+			occurrenceWindow.to = to
+		}
 	}
 
-	nextAlarms := make([]EventAlarm, 0)
-	it := recurrenceSet.Iterator()
-
-	occurrenceCount := 0
-	for {
-		v, ok := it()
-		if !ok {
-			break
-		}
+	var occurrences []time.Time
 
-		if v.Before(time.Now()) {
-			continue
+	if recurrenceSet == nil {
+		if occurrenceWindow.contains(eventStart) {
+			occurrences = append(occurrences, eventStart)
 		}
+	} else {
+		it := recurrenceSet.Iterator()
 
-		occurrenceCount++
-		for _, alarm := range alarms {
-			nextAlarms = append(nextAlarms, EventAlarm{
-				ID:        uuid.New().String(),
-				EventID:   eventID,
-				AlarmTime: v.Add(-alarm),
-				EventTime: v,
-			})
-		}
+		for {
+			v, ok := it()
+			if !ok || !v.Before(occurrenceWindow.to) {
+				break
+			}
 
-		if occurrenceCount >= 10 {
-			break
+			if !v.Before(occurrenceWindow.from) {
+				occurrences = append(occurrences, v)
+			}
 		}
 	}
 
-	return nextAlarms, nil
-}
+	nextAlarms := make([]EventAlarm, 0, len(occurrences)*len(triggers))
 
-func getAlarms(event *ical.Event) []time.Duration {
-	alarms := make([]ical.Component, 0, len(event.Children))
-
-	for _, component := range event.Children {
-		if component.Name == ical.CompAlarm {
-			alarms = append(alarms, *component)
-		}
-	}
+	for _, occurrence := range occurrences {
+		for _, trigger := range triggers {
+			if !trigger.absolute.IsZero() && !occurrence.Equal(eventStart) {
+				continue
+			}
 
-	alarmsBefore := make([]time.Duration, 0, len(alarms))
+			alarmTime := trigger.at(occurrence, occurrence.Add(duration))
+			if !window.contains(alarmTime) {
+				continue
+			}
 
-	for _, alarm := range alarms {
-		alarmProp := alarm.Props.Get(ical.PropTrigger)
-		duration, err := alarmProp.Duration()
-		if err != nil {
-			continue
+			nextAlarms = append(nextAlarms, EventAlarm{
+				ID:        uuid.New().String(),
+				EventID:   eventID,
+				AlarmTime: alarmTime,
+				EventTime: occurrence,
+			})
 		}
-
-		alarmsBefore = append(alarmsBefore, duration)
 	}
 
-	return alarmsBefore
+	return nextAlarms, nil
 }
@@ -0,0 +1,285 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database/databasetest"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+func TestEventIdentity(t *testing.T) {
+	testcases := []struct {
+		Name string
+
+		Props map[string]string
+
+		ExpectedUID          string
+		ExpectedRecurrenceID string
+		ExpectedErr          error
+	}{{
+		Name:        "Single event",
+		Props:       map[string]string{ical.PropUID: "event@example.com"},
+		ExpectedUID: "event@example.com",
+	}, {
+		Name: "Recurrence override in UTC",
+		Props: map[string]string{
+			ical.PropUID:          "standup@example.com",
+			ical.PropRecurrenceID: "20250108T090000Z",
+		},
+		ExpectedUID:          "standup@example.com",
+		ExpectedRecurrenceID: "2025-01-08T09:00:00Z",
+	}, {
+		Name:        "Missing UID",
+		Props:       map[string]string{ical.PropSummary: "No identity"},
+		ExpectedErr: ErrMissingUID,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			event := ical.NewEvent()
+			for name, value := range testcase.Props {
+				prop := ical.NewProp(name)
+				prop.Value = value
+				event.Props.Set(prop)
+			}
+
+			uid, recurrenceID, err := eventIdentity(event, timezone.New(ical.NewCalendar(), nil))
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testcase.ExpectedUID, uid)
+			require.Equal(t, testcase.ExpectedRecurrenceID, recurrenceID)
+		})
+	}
+}
+
+func TestCalculateNextAlarms(t *testing.T) {
+	calendar := &pb.Calendar{
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+		DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(15 * time.Minute)}},
+	}
+
+	window := timerange{
+		from: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		Name string
+
+		Props map[string]string
+
+		ExpectedEventTimes []time.Time
+	}{{
+		Name: "Single event within horizon",
+		Props: map[string]string{
+			ical.PropDateTimeStart: "20250310T090000Z",
+		},
+		ExpectedEventTimes: []time.Time{time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)},
+	}, {
+		Name: "Single event beyond horizon",
+		Props: map[string]string{
+			ical.PropDateTimeStart: "20250410T090000Z",
+		},
+	}, {
+		Name: "Daily event is expanded for the whole horizon",
+		Props: map[string]string{
+			ical.PropDateTimeStart:  "20250101T090000Z",
+			ical.PropRecurrenceRule: "FREQ=DAILY",
+		},
+		ExpectedEventTimes: func() []time.Time {
+			var times []time.Time
+			for day := 1; day <= 30; day++ {
+				times = append(times, time.Date(2025, 3, day, 9, 0, 0, 0, time.UTC))
+			}
+
+			return times
+		}(),
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			event := ical.NewEvent()
+			for name, value := range testcase.Props {
+				prop := ical.NewProp(name)
+				prop.Value = value
+				event.Props.Set(prop)
+			}
+
+			feed := &feed{zones: timezone.New(ical.NewCalendar(), nil)}
+
+			alarms, err := calculateNextAlarms(calendar, "event-1", event, feed, nil, window)
+			require.NoError(t, err)
+
+			eventTimes := make([]time.Time, 0, len(alarms))
+			for _, alarm := range alarms {
+				require.Equal(t, alarm.EventTime.Add(-15*time.Minute), alarm.AlarmTime)
+				eventTimes = append(eventTimes, alarm.EventTime)
+			}
+
+			require.Len(t, eventTimes, len(testcase.ExpectedEventTimes))
+
+			for i := range eventTimes {
+				require.True(t, testcase.ExpectedEventTimes[i].Equal(eventTimes[i]), "occurrence %d", i)
+			}
+		})
+	}
+}
+
+// fakeDatabase connects to a fake database on which the lock of every calendar is either free or held.
+func fakeDatabase(lockHeld bool) *databasetest.Connector {
+	return &databasetest.Connector{Rows: map[string][][]driver.Value{"pg_try_advisory_xact_lock": {{!lockHeld}}}}
+}
+
+func TestImport_Close_EmptyFeed(t *testing.T) {
+	connector := fakeDatabase(false)
+	repo := NewRepository(sql.OpenDB(connector), nil)
+
+	imp, err := repo.StartImport(context.Background(), "calendar-1", SyncState{})
+	require.NoError(t, err)
+	require.NoError(t, imp.Close(nil))
+
+	// The events that are kept must be an empty array, as no event matches a NULL array and all would be kept
+	deletes := connector.Statements("DELETE FROM calendar_events")
+	require.Len(t, deletes, 1)
+	require.Equal(t, []string{}, deletes[0].Args[1])
+}
+
+func TestImport_Close_CalDAVChanges(t *testing.T) {
+	connector := fakeDatabase(false)
+	repo := NewRepository(sql.OpenDB(connector), nil)
+
+	imp, err := repo.StartImport(context.Background(), "calendar-1", SyncState{
+		SyncToken: "http://example.com/sync/2",
+		CalDAVChanges: CalDAVChanges{
+			Changed: map[string]string{"/calendars/alice/team/a.ics": "BEGIN:VCALENDAR"},
+			Removed: []string{"/calendars/alice/team/c.ics"},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, imp.Close(nil))
+
+	// The objects are stored in the transaction that stores the sync-token they match
+	deletes := connector.Statements("delete from calendar_caldav_objects")
+	require.Len(t, deletes, 1)
+	require.True(t, deletes[0].InTx)
+	require.Equal(t, []string{"/calendars/alice/team/c.ics"}, deletes[0].Args[1])
+
+	inserts := connector.Statements("insert into calendar_caldav_objects")
+	require.Len(t, inserts, 1)
+	require.True(t, inserts[0].InTx)
+	require.Equal(t, []string{"/calendars/alice/team/a.ics"}, inserts[0].Args[1])
+	require.Equal(t, []string{"BEGIN:VCALENDAR"}, inserts[0].Args[2])
+}
+
+func TestRepository_CalendarLock(t *testing.T) {
+	repo := NewRepository(sql.OpenDB(fakeDatabase(true)), nil)
+
+	_, err := repo.StartImport(context.Background(), "calendar-1", SyncState{})
+	require.ErrorIs(t, err, ErrImportInProgress)
+
+	err = repo.RecalculateAlarms(context.Background(), &pb.Calendar{Id: "calendar-1"}, timerange{})
+	require.ErrorIs(t, err, ErrImportInProgress)
+}
+
+// movedOccurrenceFeed has a daily series whose occurrence on March 12th was moved to March 5th.
+const movedOccurrenceFeed = `BEGIN:VCALENDAR
+PRODID:test
+VERSION:2.0
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20250303T090000Z
+DTEND:20250303T091500Z
+RRULE:FREQ=DAILY;COUNT=10
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+RECURRENCE-ID:20250312T090000Z
+DTSTART:20250305T120000Z
+DTEND:20250305T121500Z
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestRepository_EndedOverride(t *testing.T) {
+	calendar := &pb.Calendar{
+		Id:                  "calendar-1",
+		DefaultReminderMode: pb.DefaultReminderMode_DEFAULT_REMINDER_MODE_REPLACE,
+		DefaultReminders:    []*pb.DefaultReminder{{Before: durationpb.New(10 * time.Minute)}},
+	}
+
+	// The override moved the occurrence before the window, so it has ended, while its original slot is still to come
+	window := timerange{
+		from: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	movedSlot := time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC)
+	expectedEventTimes := []time.Time{
+		time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC),
+	}
+
+	cal, err := ical.NewDecoder(strings.NewReader(strings.ReplaceAll(movedOccurrenceFeed, "\n", "\r\n"))).Decode()
+	require.NoError(t, err)
+
+	t.Run("Import", func(t *testing.T) {
+		connector := fakeDatabase(false)
+		repo := NewRepository(sql.OpenDB(connector), nil)
+
+		feed, err := newFeed(cal, time.UTC)
+		require.NoError(t, err)
+
+		imp, err := repo.StartImport(context.Background(), calendar.Id, SyncState{})
+		require.NoError(t, err)
+
+		for _, event := range cal.Events() {
+			require.NoError(t, imp.UpsertEvent(context.Background(), calendar, &event, feed, window))
+		}
+
+		require.NoError(t, imp.Close(nil))
+
+		// Only the recurring event is stored, together with the occurrence the ended override moved
+		inserts := connector.Statements("insert into calendar_events")
+		require.Len(t, inserts, 1)
+		require.Equal(t, []time.Time{movedSlot}, inserts[0].Args[5])
+
+		alarms := connector.Statements("insert into calendar_event_alarms")
+		require.Len(t, alarms, 1)
+		require.Equal(t, expectedEventTimes, alarms[0].Args[2])
+	})
+
+	t.Run("Recalculation", func(t *testing.T) {
+		// Once the override ended, only the recurring event is stored
+		master, err := encodeEvent("event-1", &cal.Events()[0], nil)
+		require.NoError(t, err)
+
+		connector := fakeDatabase(false)
+		connector.Rows["select id, data from calendar_events"] = [][]driver.Value{{"event-1", master}}
+		connector.Rows["unnest(overridden_occurrences)"] = [][]driver.Value{{"standup@example.com", movedSlot}}
+
+		repo := NewRepository(sql.OpenDB(connector), nil)
+
+		require.NoError(t, repo.RecalculateAlarms(context.Background(), calendar, window))
+
+		alarms := connector.Statements("insert into calendar_event_alarms")
+		require.Len(t, alarms, 1)
+		require.Equal(t, expectedEventTimes, alarms[0].Args[2])
+	})
+}
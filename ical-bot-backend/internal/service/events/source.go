@@ -0,0 +1,132 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/emersion/go-ical"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var ErrSourceUnavailable = errors.New("calendar source is not available")
+
+// Source fetches the feed of a calendar. If the feed didn't change since the last sync, as told by the cache
+// validators and hash of the calendar, the returned iCal calendar is nil. The returned sync state is stored with
+// the calendar either way.
+type Source interface {
+	Fetch(ctx context.Context, calendar *pb.Calendar) (*ical.Calendar, SyncState, error)
+}
+
+// Sources are the sources that calendars are imported from, selected by the source of each calendar. Calendars
+// with a nil source can't be imported.
+type Sources struct {
+	HTTP   Source
+	CalDAV Source
+	File   Source
+	Inline Source
+}
+
+// of returns the source of the calendar. Calendars without a source predate them and are fetched from their iCal URL.
+func (s Sources) of(calendar *pb.Calendar) (Source, error) {
+	var source Source
+
+	switch calendar.Source.(type) {
+	case *pb.Calendar_Caldav:
+		source = s.CalDAV
+	case *pb.Calendar_File:
+		source = s.File
+	case *pb.Calendar_Inline:
+		source = s.Inline
+	default:
+		source = s.HTTP
+	}
+
+	if source == nil {
+		return nil, ErrSourceUnavailable
+	}
+
+	return source, nil
+}
+
+// CredentialStore returns the decrypted credentials of calendars.
+type CredentialStore interface {
+	GetCredentials(ctx context.Context, id string) (*pb.FeedCredentials, error)
+}
+
+// loadCredentials returns the credentials of the calendar, or nil if it has none. Credentials that are passed with
+// the calendar, like those of a preview, take precedence over the stored ones.
+func loadCredentials(
+	ctx context.Context, store CredentialStore, calendar *pb.Calendar,
+) (*pb.FeedCredentials, error) {
+	if calendar.Credentials != nil {
+		return calendar.Credentials, nil
+	}
+
+	if !calendar.HasCredentials {
+		return nil, nil
+	}
+
+	credentials, err := store.GetCredentials(ctx, calendar.Id)
+	if err != nil {
+		return nil, fmt.Errorf("loading credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+// SizeLimits are the size limits of feeds in bytes.
+type SizeLimits struct {
+	// Default is the limit of calendars that don't override it
+	Default int64
+	// Max caps the limits that calendars override the default with
+	Max int64
+}
+
+// of returns the maximum size of the feed of the calendar in bytes. Overrides above the maximum, e.g. from before the
+// maximum was lowered, are capped.
+func (l SizeLimits) of(calendar *pb.Calendar) int64 {
+	if calendar.MaxIcalSize > 0 {
+		return min(calendar.MaxIcalSize, max(l.Max, l.Default))
+	}
+
+	return l.Default
+}
+
+// objectsSize returns the total size of the calendar objects in bytes.
+func objectsSize(objects []calendarObject) int64 {
+	var size int64
+
+	for _, object := range objects {
+		size += int64(len(object.data))
+	}
+
+	return size
+}
+
+// decodeFeed decodes the iCal data of a feed, unless its hash is the same as on the last sync.
+func decodeFeed(
+	calendar *pb.Calendar, data []byte, syncState SyncState, limit int64,
+) (*ical.Calendar, SyncState, error) {
+	if int64(len(data)) > limit {
+		return nil, syncState, &SizeLimitError{Limit: limit}
+	}
+
+	hash := sha256.Sum256(data)
+	syncState.Hash = hash[:]
+	syncState.Size = int64(len(data))
+
+	if bytes.Equal(calendar.LastSyncHash, syncState.Hash) {
+		return nil, syncState, nil
+	}
+
+	icalCalendar, err := ical.NewDecoder(bytes.NewReader(data)).Decode()
+	if err != nil {
+		return nil, syncState, fmt.Errorf("%w: %w", ErrInvalidIcal, err)
+	}
+
+	return icalCalendar, syncState, nil
+}
@@ -0,0 +1,248 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+type fakeInlineDataStore []byte
+
+func (f fakeInlineDataStore) GetInlineData(context.Context, string) ([]byte, error) {
+	return f, nil
+}
+
+func TestFileSource(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "team"), 0o755))
+
+	for name, content := range map[string]string{
+		"personal.ics":   fmt.Sprintf(caldavEvent, "personal@example.com"),
+		"team/b.ics":     fmt.Sprintf(caldavEvent, "b@example.com"),
+		"team/a.ics":     fmt.Sprintf(caldavEvent, "a@example.com"),
+		"team/notes.txt": "not a calendar",
+	} {
+		data := strings.ReplaceAll(content, "\n", "\r\n")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600))
+	}
+
+	root, err := os.OpenRoot(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = root.Close() })
+
+	testcases := []struct {
+		Name string
+
+		Path string
+
+		ExpectedUIDs []string
+		ExpectedErr  error
+	}{{
+		Name:         "File",
+		Path:         "personal.ics",
+		ExpectedUIDs: []string{"personal@example.com"},
+	}, {
+		Name:         "Paths can't leave the root",
+		Path:         "../../personal.ics",
+		ExpectedUIDs: []string{"personal@example.com"},
+	}, {
+		Name:         "Directory",
+		Path:         "/team/",
+		ExpectedUIDs: []string{"a@example.com", "b@example.com"},
+	}, {
+		Name:        "Missing",
+		Path:        "team/missing.ics",
+		ExpectedErr: os.ErrNotExist,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			calendar := &pb.Calendar{Id: "calendar-1", Source: &pb.Calendar_File{File: &pb.FileSource{Path: testcase.Path}}}
+
+			icalCalendar, syncState, err := NewFileSource(root, testSizeLimits).Fetch(context.Background(), calendar)
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var uids []string
+			for _, event := range icalCalendar.Events() {
+				uids = append(uids, event.Props.Get("UID").Value)
+			}
+
+			require.Equal(t, testcase.ExpectedUIDs, uids)
+			require.Len(t, syncState.Hash, 32)
+
+			// A second sync with the stored hash finds the files unchanged
+			calendar.LastSyncHash = syncState.Hash
+
+			icalCalendar, _, err = NewFileSource(root, testSizeLimits).Fetch(context.Background(), calendar)
+			require.NoError(t, err)
+			require.Nil(t, icalCalendar)
+		})
+	}
+}
+
+func TestInlineSource(t *testing.T) {
+	source := NewInlineSource(fakeInlineDataStore(testFeed), testSizeLimits)
+	calendar := &pb.Calendar{Id: "calendar-1", Source: &pb.Calendar_Inline{Inline: &pb.InlineSource{}}}
+
+	icalCalendar, syncState, err := source.Fetch(context.Background(), calendar)
+	require.NoError(t, err)
+	require.NotNil(t, icalCalendar)
+
+	hash := sha256.Sum256([]byte(testFeed))
+	require.Equal(t, hash[:], syncState.Hash)
+
+	calendar.LastSyncHash = syncState.Hash
+
+	icalCalendar, _, err = source.Fetch(context.Background(), calendar)
+	require.NoError(t, err)
+	require.Nil(t, icalCalendar)
+}
+
+func TestInlineSource_SizeLimit(t *testing.T) {
+	testcases := []struct {
+		Name        string
+		MaxIcalSize int64
+		Max         int64
+
+		ExpectedErr error
+	}{{
+		Name:        "Default limit",
+		MaxIcalSize: 0,
+		ExpectedErr: &SizeLimitError{Limit: 10},
+	}, {
+		Name:        "Raised limit",
+		MaxIcalSize: int64(len(testFeed)),
+	}, {
+		Name:        "Limit just below the feed size",
+		MaxIcalSize: int64(len(testFeed)) - 1,
+		ExpectedErr: &SizeLimitError{Limit: int64(len(testFeed)) - 1},
+	}, {
+		Name:        "Limit above the maximum",
+		MaxIcalSize: math.MaxInt64,
+		Max:         int64(len(testFeed)) - 1,
+		ExpectedErr: &SizeLimitError{Limit: int64(len(testFeed)) - 1},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			limits := SizeLimits{Default: 10, Max: cmp.Or(testcase.Max, int64(len(testFeed)))}
+			source := NewInlineSource(fakeInlineDataStore(testFeed), limits)
+			calendar := &pb.Calendar{
+				Id:          "calendar-1",
+				Source:      &pb.Calendar_Inline{Inline: &pb.InlineSource{}},
+				MaxIcalSize: testcase.MaxIcalSize,
+			}
+
+			_, _, err := source.Fetch(context.Background(), calendar)
+			if testcase.ExpectedErr != nil {
+				require.Equal(t, testcase.ExpectedErr, err)
+				require.ErrorIs(t, err, ErrIcalSizeExceeded)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSources_Of(t *testing.T) {
+	sources := Sources{HTTP: NewHTTPSource(nil, nil, testSizeLimits), Inline: NewInlineSource(nil, testSizeLimits)}
+
+	source, err := sources.of(&pb.Calendar{IcalUrl: "https://example.com/calendar.ics"})
+	require.NoError(t, err)
+	require.Equal(t, sources.HTTP, source)
+
+	source, err = sources.of(&pb.Calendar{Source: &pb.Calendar_Inline{Inline: &pb.InlineSource{}}})
+	require.NoError(t, err)
+	require.Equal(t, sources.Inline, source)
+
+	_, err = sources.of(&pb.Calendar{Source: &pb.Calendar_File{File: &pb.FileSource{Path: "team.ics"}}})
+	require.ErrorIs(t, err, ErrSourceUnavailable)
+}
+
+func TestHTTPSource_RedirectCredentials(t *testing.T) {
+	var headers http.Header
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		_, _ = io.WriteString(w, testFeed)
+	}))
+	defer target.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/same-host", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/calendar.ics", http.StatusFound)
+	})
+	mux.HandleFunc("/other-host", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+"/calendar.ics", http.StatusFound)
+	})
+	mux.HandleFunc("/calendar.ics", func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		_, _ = io.WriteString(w, testFeed)
+	})
+
+	origin := httptest.NewServer(mux)
+	defer origin.Close()
+
+	credentials := &pb.FeedCredentials{
+		Authorization: &pb.FeedCredentials_BearerToken{BearerToken: "token"},
+		Headers:       map[string]string{"X-Api-Key": "key"},
+	}
+
+	testcases := []struct {
+		Name string
+		Path string
+
+		ExpectedCredentials bool
+	}{{
+		Name:                "Same host",
+		Path:                "/same-host",
+		ExpectedCredentials: true,
+	}, {
+		Name: "Other host",
+		Path: "/other-host",
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			headers = nil
+
+			source := NewHTTPSource(origin.Client(), nil, testSizeLimits)
+
+			_, _, err := source.Fetch(context.Background(), &pb.Calendar{
+				Credentials: credentials,
+				Source:      &pb.Calendar_Http{Http: &pb.HTTPSource{Url: origin.URL + testcase.Path}},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, headers)
+
+			if testcase.ExpectedCredentials {
+				require.Equal(t, "Bearer token", headers.Get("Authorization"))
+				require.Equal(t, "key", headers.Get("X-Api-Key"))
+			} else {
+				require.Empty(t, headers.Get("Authorization"))
+				require.Empty(t, headers.Get("X-Api-Key"))
+			}
+		})
+	}
+}
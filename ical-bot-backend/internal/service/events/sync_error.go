@@ -0,0 +1,178 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	pbStatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/httpclient"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/secret"
+)
+
+// errorDomain is the domain of the ErrorInfo details attached to sync errors.
+const errorDomain = "ical-bot.patrick246.github.com"
+
+// Reasons and metadata keys of the ErrorInfo details attached to sync errors.
+const (
+	reasonInvalidURL    = "INVALID_URL"
+	reasonInvalidPath   = "INVALID_PATH"
+	reasonSource        = "SOURCE_UNAVAILABLE"
+	reasonCredentials   = "CREDENTIALS_UNAVAILABLE"
+	reasonFetchFailed   = "FETCH_FAILED"
+	reasonNotAllowed    = "ADDRESS_NOT_ALLOWED"
+	reasonHTTPStatus    = "UNEXPECTED_HTTP_STATUS"
+	reasonSizeExceeded  = "ICAL_SIZE_EXCEEDED"
+	reasonTooManyEvents = "TOO_MANY_EVENTS"
+	reasonInvalidIcal   = "INVALID_ICAL"
+	reasonDeadline      = "DEADLINE_EXCEEDED"
+	reasonInternal      = "INTERNAL"
+
+	metadataHTTPStatus = "http_status"
+	metadataSizeLimit  = "size_limit_bytes"
+	metadataEventLimit = "event_limit"
+)
+
+var ErrInvalidIcal = errors.New("invalid iCal data")
+
+// StatusCodeError is returned when the feed host responds with a non-successful HTTP status code.
+type StatusCodeError struct {
+	StatusCode int
+	// RetryAfter is the delay the host asked for with a Retry-After header, e.g. on 429 and 503 responses
+	RetryAfter time.Duration
+}
+
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("%s: %d", ErrUnexpectedStatusCode, e.StatusCode)
+}
+
+func (e *StatusCodeError) Unwrap() error {
+	return ErrUnexpectedStatusCode
+}
+
+// SizeLimitError is returned when a feed is larger than the size limit of its calendar.
+type SizeLimitError struct {
+	Limit int64
+}
+
+func (e *SizeLimitError) Error() string {
+	return fmt.Sprintf("%s of %d bytes", ErrIcalSizeExceeded, e.Limit)
+}
+
+func (e *SizeLimitError) Unwrap() error {
+	return ErrIcalSizeExceeded
+}
+
+// EventLimitError is returned when a feed has more events than a calendar may store.
+type EventLimitError struct {
+	Limit int
+}
+
+func (e *EventLimitError) Error() string {
+	return fmt.Sprintf("%s of %d events", ErrTooManyEvents, e.Limit)
+}
+
+func (e *EventLimitError) Unwrap() error {
+	return ErrTooManyEvents
+}
+
+// syncErrorStatus converts an import error into the status stored as the calendar's last sync error.
+func syncErrorStatus(err error) *pbStatus.Status {
+	var (
+		statusCodeErr *StatusCodeError
+		sizeLimitErr  *SizeLimitError
+		eventLimitErr *EventLimitError
+		urlErr        *url.Error
+		code          codes.Code
+		message       = err.Error()
+		info          = &errdetails.ErrorInfo{Domain: errorDomain}
+	)
+
+	switch {
+	case errors.As(err, &statusCodeErr):
+		code = httpStatusCode(statusCodeErr.StatusCode)
+		info.Reason = reasonHTTPStatus
+		info.Metadata = map[string]string{metadataHTTPStatus: strconv.Itoa(statusCodeErr.StatusCode)}
+	case errors.As(err, &sizeLimitErr):
+		code = codes.ResourceExhausted
+		info.Reason = reasonSizeExceeded
+		info.Metadata = map[string]string{metadataSizeLimit: strconv.FormatInt(sizeLimitErr.Limit, 10)}
+	case errors.As(err, &eventLimitErr):
+		code = codes.ResourceExhausted
+		info.Reason = reasonTooManyEvents
+		info.Metadata = map[string]string{metadataEventLimit: strconv.Itoa(eventLimitErr.Limit)}
+	case errors.Is(err, ErrInvalidIcal), errors.Is(err, ErrInvalidMultistatus):
+		code = codes.InvalidArgument
+		info.Reason = reasonInvalidIcal
+	case errors.Is(err, secret.ErrNoKey), errors.Is(err, secret.ErrDecryptFailed):
+		code = codes.FailedPrecondition
+		info.Reason = reasonCredentials
+	case errors.Is(err, ErrSourceUnavailable):
+		code = codes.FailedPrecondition
+		info.Reason = reasonSource
+	case errors.Is(err, ErrInvalidPath):
+		code = codes.InvalidArgument
+		info.Reason = reasonInvalidPath
+	case errors.Is(err, fs.ErrNotExist):
+		code = codes.NotFound
+		info.Reason = reasonFetchFailed
+	case errors.Is(err, httpclient.ErrAddressNotAllowed):
+		// The error contains the address the host resolved to, which must not reveal the internal network
+		code = codes.PermissionDenied
+		info.Reason = reasonNotAllowed
+		message = "the feed host resolves to an address that is not allowed"
+	case errors.Is(err, httpclient.ErrTooManyRedirects), errors.Is(err, httpclient.ErrSchemeNotAllowed):
+		code = codes.FailedPrecondition
+		info.Reason = reasonFetchFailed
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+		info.Reason = reasonDeadline
+	case errors.As(err, &urlErr) && urlErr.Op == "parse":
+		code = codes.InvalidArgument
+		info.Reason = reasonInvalidURL
+	case errors.As(err, &urlErr):
+		code = codes.Unavailable
+		info.Reason = reasonFetchFailed
+	default:
+		code = codes.Internal
+		info.Reason = reasonInternal
+	}
+
+	st, detailsErr := status.New(code, message).WithDetails(info)
+	if detailsErr != nil {
+		return status.New(code, message).Proto()
+	}
+
+	return st.Proto()
+}
+
+// StatusError converts an import error into a gRPC status error, with the same details as stored sync errors.
+func StatusError(err error) error {
+	return status.ErrorProto(syncErrorStatus(err))
+}
+
+func httpStatusCode(statusCode int) codes.Code {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case statusCode == http.StatusForbidden:
+		return codes.PermissionDenied
+	case statusCode == http.StatusNotFound, statusCode == http.StatusGone:
+		return codes.NotFound
+	case statusCode == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case statusCode >= http.StatusInternalServerError:
+		return codes.Unavailable
+	default:
+		return codes.FailedPrecondition
+	}
+}
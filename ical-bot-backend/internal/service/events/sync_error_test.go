@@ -0,0 +1,140 @@
+package events
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/httpclient"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/secret"
+)
+
+func TestSyncErrorStatus(t *testing.T) {
+	testcases := []struct {
+		Name string
+
+		Err error
+
+		ExpectedCode     codes.Code
+		ExpectedReason   string
+		ExpectedMetadata map[string]string
+		// ExpectedMessage is the message of the status if it isn't the message of the error
+		ExpectedMessage string
+	}{{
+		Name:             "Server error",
+		Err:              &StatusCodeError{StatusCode: 503},
+		ExpectedCode:     codes.Unavailable,
+		ExpectedReason:   reasonHTTPStatus,
+		ExpectedMetadata: map[string]string{metadataHTTPStatus: "503"},
+	}, {
+		Name:             "Not found",
+		Err:              &StatusCodeError{StatusCode: 404},
+		ExpectedCode:     codes.NotFound,
+		ExpectedReason:   reasonHTTPStatus,
+		ExpectedMetadata: map[string]string{metadataHTTPStatus: "404"},
+	}, {
+		Name:             "Rate limited",
+		Err:              &StatusCodeError{StatusCode: 429},
+		ExpectedCode:     codes.ResourceExhausted,
+		ExpectedReason:   reasonHTTPStatus,
+		ExpectedMetadata: map[string]string{metadataHTTPStatus: "429"},
+	}, {
+		Name:             "Size exceeded",
+		Err:              &SizeLimitError{Limit: 10485760},
+		ExpectedCode:     codes.ResourceExhausted,
+		ExpectedReason:   reasonSizeExceeded,
+		ExpectedMetadata: map[string]string{metadataSizeLimit: "10485760"},
+	}, {
+		Name:             "Too many events",
+		Err:              &EventLimitError{Limit: 10000},
+		ExpectedCode:     codes.ResourceExhausted,
+		ExpectedReason:   reasonTooManyEvents,
+		ExpectedMetadata: map[string]string{metadataEventLimit: "10000"},
+	}, {
+		Name:           "Parse failure",
+		Err:            fmt.Errorf("%w: %w", ErrInvalidIcal, errors.New("ical: malformed line")),
+		ExpectedCode:   codes.InvalidArgument,
+		ExpectedReason: reasonInvalidIcal,
+	}, {
+		Name:           "Invalid URL",
+		Err:            &url.Error{Op: "parse", URL: "calendar.ics", Err: errors.New("invalid URI for request")},
+		ExpectedCode:   codes.InvalidArgument,
+		ExpectedReason: reasonInvalidURL,
+	}, {
+		Name:           "Unsupported scheme",
+		Err:            &url.Error{Op: "parse", URL: "file:///etc/passwd", Err: ErrUnsupportedScheme},
+		ExpectedCode:   codes.InvalidArgument,
+		ExpectedReason: reasonInvalidURL,
+	}, {
+		Name:           "Credentials can't be decrypted",
+		Err:            fmt.Errorf("loading credentials: %w", secret.ErrDecryptFailed),
+		ExpectedCode:   codes.FailedPrecondition,
+		ExpectedReason: reasonCredentials,
+	}, {
+		Name:           "Source unavailable",
+		Err:            ErrSourceUnavailable,
+		ExpectedCode:   codes.FailedPrecondition,
+		ExpectedReason: reasonSource,
+	}, {
+		Name:           "Missing file",
+		Err:            &fs.PathError{Op: "stat", Path: "team.ics", Err: fs.ErrNotExist},
+		ExpectedCode:   codes.NotFound,
+		ExpectedReason: reasonFetchFailed,
+	}, {
+		Name:           "Connection failure",
+		Err:            &url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection refused")},
+		ExpectedCode:   codes.Unavailable,
+		ExpectedReason: reasonFetchFailed,
+	}, {
+		Name: "Address not allowed",
+		Err: &url.Error{Op: "Get", URL: "http://internal.example.com", Err: &net.OpError{
+			Op: "dial", Net: "tcp", Err: fmt.Errorf("%w: 10.0.0.5", httpclient.ErrAddressNotAllowed),
+		}},
+		ExpectedCode:    codes.PermissionDenied,
+		ExpectedReason:  reasonNotAllowed,
+		ExpectedMessage: "the feed host resolves to an address that is not allowed",
+	}, {
+		Name: "Too many redirects",
+		Err: &url.Error{
+			Op: "Get", URL: "https://example.com/calendar.ics", Err: httpclient.ErrTooManyRedirects,
+		},
+		ExpectedCode:   codes.FailedPrecondition,
+		ExpectedReason: reasonFetchFailed,
+	}, {
+		Name:           "Deadline",
+		Err:            fmt.Errorf("importing: %w", context.DeadlineExceeded),
+		ExpectedCode:   codes.DeadlineExceeded,
+		ExpectedReason: reasonDeadline,
+	}, {
+		Name:           "Other",
+		Err:            errors.New("database gone"),
+		ExpectedCode:   codes.Internal,
+		ExpectedReason: reasonInternal,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			st := syncErrorStatus(testcase.Err)
+
+			require.Equal(t, int32(testcase.ExpectedCode), st.Code)
+			require.Equal(t, cmp.Or(testcase.ExpectedMessage, testcase.Err.Error()), st.Message)
+			require.Len(t, st.Details, 1)
+
+			var info errdetails.ErrorInfo
+
+			require.NoError(t, st.Details[0].UnmarshalTo(&info))
+			require.Equal(t, errorDomain, info.Domain)
+			require.Equal(t, testcase.ExpectedReason, info.Reason)
+			require.Equal(t, testcase.ExpectedMetadata, info.Metadata)
+		})
+	}
+}
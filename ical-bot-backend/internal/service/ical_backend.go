@@ -4,25 +4,61 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"log/slog"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/secret"
 	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/calendar"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/channel"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/events"
 	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
 )
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+// outputOnlyPaths are the fields of calendars that are maintained by imports. They can't be updated through the API.
+var outputOnlyPaths = []string{
+	"http_etag", "http_last_modified", "next_sync_time", "sync_failures", "caldav.sync_token", "caldav.ctag",
+}
+
 type ICalBackend struct {
 	pb.UnimplementedIcalBotServiceServer
 
-	calendarRepo *calendar.Repository
+	calendarRepo     *calendar.Repository
+	channelRepo      *channel.Repository
+	notificationRepo NotificationRepository
+	eventRepo        *events.Repository
+	calendarImport   *events.IcalImport
+	notificationCfg  config.Notifications
+	logger           *slog.Logger
 }
 
-func NewICalBackend(calendarRepo *calendar.Repository) *ICalBackend {
+func NewICalBackend(
+	calendarRepo *calendar.Repository,
+	channelRepo *channel.Repository,
+	notificationRepo NotificationRepository,
+	eventRepo *events.Repository,
+	calendarImport *events.IcalImport,
+	notificationCfg config.Notifications,
+	logger *slog.Logger,
+) *ICalBackend {
 	return &ICalBackend{
-		calendarRepo: calendarRepo,
+		calendarRepo:     calendarRepo,
+		channelRepo:      channelRepo,
+		notificationRepo: notificationRepo,
+		eventRepo:        eventRepo,
+		calendarImport:   calendarImport,
+		notificationCfg:  notificationCfg,
+		logger:           logger,
 	}
 }
 
@@ -47,7 +83,7 @@ func (b *ICalBackend) ListCalendars(
 		return nil, status.Error(codes.InvalidArgument, "invalid page token")
 	}
 
-	calendars, nextPageToken, err := b.calendarRepo.ListCalendars(ctx, request.PageSize, pageToken, request.Filter)
+	calendars, nextPageToken, err := b.calendarRepo.ListCalendars(ctx, pageSize(request.PageSize), pageToken, request.Filter)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +101,15 @@ func (b *ICalBackend) ListCalendars(
 
 func (b *ICalBackend) CreateCalendar(ctx context.Context, request *pb.CreateCalendarRequest) (*pb.Calendar, error) {
 	newCalendar, err := b.calendarRepo.CreateCalendar(ctx, request.Calendar)
+	if errors.Is(err, calendar.ErrInvalidTimeZone) || errors.Is(err, calendar.ErrInvalidReminder) ||
+		errors.Is(err, calendar.ErrInvalidCredentials) || errors.Is(err, calendar.ErrInvalidSyncInterval) ||
+		errors.Is(err, calendar.ErrInvalidSizeLimit) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, secret.ErrNoKey) {
+		return nil, status.Error(codes.FailedPrecondition, "storing credentials requires an encryption key")
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +118,24 @@ func (b *ICalBackend) CreateCalendar(ctx context.Context, request *pb.CreateCale
 }
 
 func (b *ICalBackend) UpdateCalendar(ctx context.Context, request *pb.UpdateCalendarRequest) (*pb.Calendar, error) {
+	for _, path := range request.FieldMask.GetPaths() {
+		if slices.Contains(outputOnlyPaths, path) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is output only", path)
+		}
+	}
+
 	c, err := b.calendarRepo.UpdateCalendar(ctx, request.Calendar, request.FieldMask)
 	if errors.Is(err, calendar.ErrNotFound) {
 		return nil, status.Error(codes.NotFound, "calendar not found")
 	}
+	if errors.Is(err, calendar.ErrInvalidTimeZone) || errors.Is(err, calendar.ErrInvalidReminder) ||
+		errors.Is(err, calendar.ErrInvalidCredentials) || errors.Is(err, calendar.ErrInvalidSyncInterval) ||
+		errors.Is(err, calendar.ErrInvalidSizeLimit) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, secret.ErrNoKey) {
+		return nil, status.Error(codes.FailedPrecondition, "storing credentials requires an encryption key")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -93,52 +152,280 @@ func (b *ICalBackend) DeleteCalendar(ctx context.Context, request *pb.DeleteCale
 	return &emptypb.Empty{}, nil
 }
 
+func (b *ICalBackend) SyncCalendar(
+	ctx context.Context, request *pb.SyncCalendarRequest,
+) (*pb.SyncCalendarResponse, error) {
+	resp, err := b.calendarImport.Sync(ctx, request.Id)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+	if errors.Is(err, events.ErrImportInProgress) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (b *ICalBackend) ListCalendarImports(
+	ctx context.Context, request *pb.ListCalendarImportsRequest,
+) (*pb.ListCalendarImportsResponse, error) {
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	_, err = b.calendarRepo.GetCalendar(ctx, request.CalendarId)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	imports, nextPageToken, err := b.eventRepo.ListImports(
+		ctx, request.CalendarId, pageSize(request.PageSize), pageToken, request.ChangedOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageTokenPb, err := proto.Marshal(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCalendarImportsResponse{
+		Imports:       imports,
+		NextPageToken: base64.RawURLEncoding.EncodeToString(nextPageTokenPb),
+	}, nil
+}
+
+func (b *ICalBackend) PreviewCalendar(
+	ctx context.Context, request *pb.PreviewCalendarRequest,
+) (*pb.PreviewCalendarResponse, error) {
+	if request.Calendar == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing calendar")
+	}
+
+	err := b.calendarRepo.ValidateSettings(request.Calendar)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := b.calendarImport.Preview(ctx, request.Calendar, int(request.AlarmsPerEvent))
+	if err != nil {
+		return nil, events.StatusError(err)
+	}
+
+	return resp, nil
+}
+
+func (b *ICalBackend) ImportCalendarData(
+	ctx context.Context, request *pb.ImportCalendarDataRequest,
+) (*pb.Calendar, error) {
+	if len(request.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing iCal data")
+	}
+
+	c, err := b.calendarImport.ImportData(ctx, request.CalendarId, request.Data)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+	if errors.Is(err, events.ErrInvalidIcal) || errors.Is(err, events.ErrIcalSizeExceeded) ||
+		errors.Is(err, events.ErrTooManyEvents) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, events.ErrImportInProgress) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
 func (b *ICalBackend) GetChannel(ctx context.Context, request *pb.GetChannelRequest) (*pb.Channel, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	c, err := b.channelRepo.GetChannel(ctx, request.Id)
+	if errors.Is(err, channel.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
 func (b *ICalBackend) ListChannels(
 	ctx context.Context, request *pb.ListChannelsRequest,
 ) (*pb.ListChannelsResponse, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	channels, nextPageToken, err := b.channelRepo.ListChannels(ctx, pageSize(request.PageSize), pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageTokenPb, err := proto.Marshal(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListChannelsResponse{
+		Channels:      channels,
+		NextPageToken: base64.RawURLEncoding.EncodeToString(nextPageTokenPb),
+	}, nil
 }
 
 func (b *ICalBackend) CreateChannel(ctx context.Context, request *pb.CreateChannelRequest) (*pb.Channel, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	newChannel, err := b.channelRepo.CreateChannel(ctx, request.Channel)
+	if errors.Is(err, channel.ErrMissingChannelType) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newChannel, nil
 }
 
 func (b *ICalBackend) UpdateChannel(ctx context.Context, request *pb.UpdateChannelRequest) (*pb.Channel, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	c, err := b.channelRepo.UpdateChannel(ctx, request.Channel, request.FieldMask)
+	if errors.Is(err, channel.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+
+	if errors.Is(err, channel.ErrMissingChannelType) || errors.Is(err, channel.ErrInvalidFieldMask) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
 }
 
 func (b *ICalBackend) DeleteChannel(ctx context.Context, request *pb.DeleteChannelRequest) (*emptypb.Empty, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	err := b.channelRepo.DeleteChannel(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (b *ICalBackend) ListChannelCalendars(
+	ctx context.Context, request *pb.ListChannelCalendarsRequest,
+) (*pb.ListChannelCalendarsResponse, error) {
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	_, err = b.channelRepo.GetChannel(ctx, request.ChannelId)
+	if errors.Is(err, channel.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "channel not found")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, nextPageToken, err := b.calendarRepo.ListCalendars(
+		ctx, pageSize(request.PageSize), pageToken, &pb.ListCalendarsFilter{ChannelId: request.ChannelId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageTokenPb, err := proto.Marshal(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListChannelCalendarsResponse{
+		Calendars:     calendars,
+		NextPageToken: base64.RawURLEncoding.EncodeToString(nextPageTokenPb),
+	}, nil
 }
 
 func (b *ICalBackend) ListCalendarChannels(
 	ctx context.Context, request *pb.ListCalendarChannelsRequest,
 ) (*pb.ListCalendarChannelsResponse, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	channels, nextPageToken, err := b.channelRepo.ListCalendarChannels(
+		ctx, request.CalendarId, pageSize(request.PageSize), pageToken,
+	)
+	if err != nil {
+		return nil, calendarChannelError(err)
+	}
+
+	nextPageTokenPb, err := proto.Marshal(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListCalendarChannelsResponse{
+		Channels:      channels,
+		NextPageToken: base64.RawURLEncoding.EncodeToString(nextPageTokenPb),
+	}, nil
 }
 
 func (b *ICalBackend) CreateCalendarChannel(
 	ctx context.Context, request *pb.CreateCalendarChannelRequest,
 ) (*pb.Channel, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	c, err := b.channelRepo.CreateCalendarChannel(ctx, request.CalendarId, request.ChannelId)
+	if err != nil {
+		return nil, calendarChannelError(err)
+	}
+
+	return c, nil
 }
 
 func (b *ICalBackend) DeleteCalendarChannel(
 	ctx context.Context, request *pb.DeleteCalendarChannelRequest,
 ) (*emptypb.Empty, error) {
-	// TODO implement me
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	err := b.channelRepo.DeleteCalendarChannel(ctx, request.CalendarId, request.ChannelId)
+	if err != nil {
+		return nil, calendarChannelError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func calendarChannelError(err error) error {
+	switch {
+	case errors.Is(err, channel.ErrCalendarNotFound):
+		return status.Error(codes.NotFound, "calendar not found")
+	case errors.Is(err, channel.ErrNotFound):
+		return status.Error(codes.NotFound, "channel not found")
+	default:
+		return err
+	}
+}
+
+// pageSize applies the default page size to unset page sizes and caps requested ones at the maximum.
+func pageSize(requested int32) int32 {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+
+	return min(requested, maxPageSize)
 }
 
 func decodePageToken(pageToken string) (*pb.PageToken, error) {
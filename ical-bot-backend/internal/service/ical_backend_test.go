@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/config"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+func TestICalBackend_UpdateCalendarOutputOnly(t *testing.T) {
+	testcases := []struct {
+		Name  string
+		Paths []string
+	}{{
+		Name:  "Next sync time",
+		Paths: []string{"name", "next_sync_time"},
+	}, {
+		Name:  "HTTP ETag",
+		Paths: []string{"http_etag"},
+	}, {
+		Name:  "HTTP Last-Modified",
+		Paths: []string{"name", "http_last_modified"},
+	}, {
+		Name:  "Sync failures",
+		Paths: []string{"sync_failures"},
+	}, {
+		Name:  "CalDAV sync token",
+		Paths: []string{"caldav.collection_url", "caldav.sync_token"},
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			// The request is rejected before the calendar is updated, so no repository is needed
+			backend := NewICalBackend(nil, nil, nil, nil, nil, config.Notifications{}, nil)
+
+			_, err := backend.UpdateCalendar(context.Background(), &pb.UpdateCalendarRequest{
+				Calendar:  &pb.Calendar{Id: "calendar-1"},
+				FieldMask: &fieldmaskpb.FieldMask{Paths: testcase.Paths},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
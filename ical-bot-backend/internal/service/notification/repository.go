@@ -0,0 +1,408 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/service/channel"
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/timezone"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+var ErrEventMissing = errors.New("stored calendar contains no event")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Delivery states of an alarm for a single channel.
+const (
+	StatePending   = "pending"
+	StateInFlight  = "in_flight"
+	StateDelivered = "delivered"
+	StateFailed    = "failed"
+)
+
+// errAckTimeout is recorded as the last error of deliveries whose lease expired without an acknowledgement.
+const errAckTimeout = "notification was not acknowledged in time"
+
+type LeaseOptions struct {
+	// Duration is the time a leased delivery waits for an acknowledgement before it is delivered again
+	Duration time.Duration
+	// MaxDelay is the maximum time after the alarm time an alarm is still delivered
+	MaxDelay time.Duration
+	// MaxAttempts is the number of times a delivery is tried before it is marked as failed
+	MaxAttempts int32
+	// Limit is the maximum number of deliveries leased at once
+	Limit int32
+}
+
+// LeaseDue leases the deliveries of alarms that are due at now to the channels subscribed to their calendar,
+// skipping deliveries that succeeded, failed for good, or are leased by another stream. The leased deliveries
+// are grouped into one notification per alarm, which is identified by the alarm id.
+func (r *Repository) LeaseDue(
+	ctx context.Context, now time.Time, opts LeaseOptions,
+) ([]*pb.EventNotification, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	// Every channel subscribed to the calendar when the alarm becomes due gets its own delivery
+	_, err = tx.ExecContext(ctx, `
+		insert into calendar_event_alarm_deliveries (alarm_id, channel_id)
+		select a.id, cc.channel_id
+		from calendar_event_alarms a
+		join calendar_events e on e.id = a.event_id
+		join calendar_channels cc on cc.calendar_id = e.calendar_id
+		where a.alarm_time <= $1 and a.alarm_time > $2
+		on conflict do nothing
+	`, now, now.Add(-opts.MaxDelay))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update calendar_event_alarm_deliveries
+		set state = $3, lease_expiry = null, last_error = $4, updated_time = $1
+		where state = $5 and lease_expiry <= $1 and attempts >= $2
+	`, now, opts.MaxAttempts, StateFailed, errAckTimeout, StateInFlight)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		update calendar_event_alarm_deliveries d
+		set state = $3, lease_expiry = $2, attempts = d.attempts + 1, updated_time = $1
+		where (d.alarm_id, d.channel_id) in (
+			select alarm_id, channel_id
+			from calendar_event_alarm_deliveries
+			where state = $4 or (state = $3 and lease_expiry <= $1)
+			limit $5
+			for update skip locked
+		)
+		returning d.alarm_id, d.channel_id
+	`, now, now.Add(opts.Duration), StateInFlight, StatePending, opts.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	leased := make(map[string][]string)
+
+	for rows.Next() {
+		var alarmID, channelID string
+
+		err := rows.Scan(&alarmID, &channelID)
+		if err != nil {
+			return nil, err
+		}
+
+		leased[alarmID] = append(leased[alarmID], channelID)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getNotifications(ctx, leased)
+}
+
+// Acknowledge records the outcome of a notification that was sent to channelIDs. Deliveries to the channels listed
+// in failures are retried until they reached maxAttempts, all others are marked as delivered. Only leased deliveries
+// are changed, a late acknowledgement doesn't override a delivery that timed out in the meantime.
+func (r *Repository) Acknowledge(
+	ctx context.Context, alarmID string, channelIDs []string, failures []*pb.DeliveryFailure, maxAttempts int32,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	failed := make(map[string]struct{}, len(failures))
+
+	for _, failure := range failures {
+		failed[failure.ChannelId] = struct{}{}
+
+		_, err := tx.ExecContext(ctx, `
+			update calendar_event_alarm_deliveries
+			set
+				state = case when attempts >= $3 then $4 else $5 end,
+				lease_expiry = null,
+				last_error = $6,
+				updated_time = now()
+			where alarm_id = $1 and channel_id = $2 and state = $7
+		`, alarmID, failure.ChannelId, maxAttempts, StateFailed, StatePending, failure.Error, StateInFlight)
+		if err != nil {
+			return err
+		}
+	}
+
+	delivered := make([]string, 0, len(channelIDs))
+
+	for _, channelID := range channelIDs {
+		if _, ok := failed[channelID]; !ok {
+			delivered = append(delivered, channelID)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update calendar_event_alarm_deliveries
+		set state = $3, lease_expiry = null, last_error = null, delivered_time = now(), updated_time = now()
+		where alarm_id = $1 and channel_id = any($2::uuid[]) and state = $4
+	`, alarmID, delivered, StateDelivered, StateInFlight)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Release drops the lease on unacknowledged deliveries, making them available for immediate redelivery.
+func (r *Repository) Release(ctx context.Context, deliveries map[string][]string) error {
+	for alarmID, channelIDs := range deliveries {
+		_, err := r.db.ExecContext(ctx, `
+			update calendar_event_alarm_deliveries
+			set state = $3, lease_expiry = null, updated_time = now()
+			where alarm_id = $1 and channel_id = any($2::uuid[]) and state = $4
+		`, alarmID, channelIDs, StatePending, StateInFlight)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getNotifications loads the alarms with their events and the channels they are leased for.
+func (r *Repository) getNotifications(
+	ctx context.Context, leased map[string][]string,
+) ([]*pb.EventNotification, error) {
+	alarmIDs := make([]string, 0, len(leased))
+	channelIDs := make([]string, 0, len(leased))
+
+	for alarmID, alarmChannelIDs := range leased {
+		alarmIDs = append(alarmIDs, alarmID)
+		channelIDs = append(channelIDs, alarmChannelIDs...)
+	}
+
+	channels, err := r.getChannels(ctx, channelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		select a.id, a.event_time, e.id, e.data
+		from calendar_event_alarms a
+		join calendar_events e on e.id = a.event_id
+		where a.id = any($1::uuid[])
+		order by a.alarm_time
+	`, alarmIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	alarms := make([]storedAlarm, 0, len(alarmIDs))
+
+	for rows.Next() {
+		var alarm storedAlarm
+
+		err := rows.Scan(&alarm.id, &alarm.eventTime, &alarm.eventID, &alarm.data)
+		if err != nil {
+			return nil, err
+		}
+
+		alarms = append(alarms, alarm)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	notifications, undecodable := buildNotifications(alarms, leased, channels)
+
+	for alarmID, reason := range undecodable {
+		err := r.fail(ctx, alarmID, leased[alarmID], reason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return notifications, nil
+}
+
+// storedAlarm is a leased alarm with the stored data of its event.
+type storedAlarm struct {
+	id        string
+	eventTime time.Time
+	eventID   string
+	data      []byte
+}
+
+// buildNotifications creates the notifications of the leased alarms for the channels they are leased for. Alarms
+// whose event can't be decoded would fail every batch they are leased in, so they are returned with the reason
+// instead.
+func buildNotifications(
+	alarms []storedAlarm, leased map[string][]string, channels map[string]*pb.Channel,
+) ([]*pb.EventNotification, map[string]string) {
+	notifications := make([]*pb.EventNotification, 0, len(alarms))
+	undecodable := make(map[string]string)
+
+	for _, alarm := range alarms {
+		event, err := decodeEvent(alarm.eventID, alarm.eventTime, alarm.data)
+		if err != nil {
+			undecodable[alarm.id] = fmt.Sprintf("decoding event %s: %v", alarm.eventID, err)
+
+			continue
+		}
+
+		notification := &pb.EventNotification{
+			Id:    alarm.id,
+			Event: event,
+		}
+
+		for _, channelID := range leased[alarm.id] {
+			if ch, ok := channels[channelID]; ok {
+				notification.Channels = append(notification.Channels, ch)
+			}
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, undecodable
+}
+
+// fail marks the leased deliveries of an alarm as failed for good.
+func (r *Repository) fail(ctx context.Context, alarmID string, channelIDs []string, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		update calendar_event_alarm_deliveries
+		set state = $3, lease_expiry = null, last_error = $4, updated_time = now()
+		where alarm_id = $1 and channel_id = any($2::uuid[]) and state = $5
+	`, alarmID, channelIDs, StateFailed, reason, StateInFlight)
+
+	return err
+}
+
+func (r *Repository) getChannels(ctx context.Context, ids []string) (map[string]*pb.Channel, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		select id, type, data
+		from channels
+		where id = any($1::uuid[])
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	channels := make(map[string]*pb.Channel, len(ids))
+
+	for rows.Next() {
+		var (
+			channelID   string
+			channelType string
+			data        []byte
+		)
+
+		err := rows.Scan(&channelID, &channelType, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		ch, err := channel.Unmarshal(channelID, channelType, data)
+		if err != nil {
+			return nil, err
+		}
+
+		channels[channelID] = ch
+	}
+
+	return channels, rows.Err()
+}
+
+func decodeEvent(id string, eventTime time.Time, data []byte) (*pb.Event, error) {
+	calendar, err := ical.NewDecoder(bytes.NewReader(data)).Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	events := calendar.Events()
+	if len(events) == 0 {
+		return nil, ErrEventMissing
+	}
+
+	event := events[0]
+
+	summary, err := event.Props.Text(ical.PropSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := event.Props.Text(ical.PropDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []string
+
+	for _, prop := range event.Props.Values(ical.PropCategories) {
+		values, err := prop.TextList()
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, values...)
+	}
+
+	pbEvent := &pb.Event{
+		Id:          id,
+		Summary:     summary,
+		Description: description,
+		Categories:  categories,
+		StartTime:   timestamppb.New(eventTime),
+	}
+
+	// The stored event carries the VTIMEZONEs it references
+	zones := timezone.New(calendar, time.UTC)
+
+	start, err := zones.EventStart(&event)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := zones.EventEnd(&event)
+	if err != nil {
+		return nil, err
+	}
+
+	if end.After(start) {
+		pbEvent.Duration = durationpb.New(end.Sub(start))
+	}
+
+	return pbEvent, nil
+}
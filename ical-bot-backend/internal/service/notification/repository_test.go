@@ -0,0 +1,307 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/patrick246/ical-bot/ical-bot-backend/internal/database/databasetest"
+	pb "github.com/patrick246/ical-bot/ical-bot-backend/pkg/api/pb/ical-bot-backend/v1"
+)
+
+const storedEvent = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:event-1\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:standup@example.com\r\n" +
+	"DTSTAMP:20250101T080000Z\r\n" +
+	"DTSTART:20250106T090000Z\r\n" +
+	"DTEND:20250106T091500Z\r\n" +
+	"SUMMARY:Daily Standup\r\n" +
+	"DESCRIPTION:Yesterday\\, today\\, blockers\r\n" +
+	"CATEGORIES:work,meeting\r\n" +
+	"RRULE:FREQ=DAILY\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestDecodeEvent(t *testing.T) {
+	occurrence := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	event, err := decodeEvent("event-1", occurrence, []byte(storedEvent))
+	require.NoError(t, err)
+
+	require.Equal(t, "event-1", event.Id)
+	require.Equal(t, "Daily Standup", event.Summary)
+	require.Equal(t, "Yesterday, today, blockers", event.Description)
+	require.Equal(t, []string{"work", "meeting"}, event.Categories)
+	require.Equal(t, occurrence, event.StartTime.AsTime())
+	require.Equal(t, 15*time.Minute, event.Duration.AsDuration())
+}
+
+func TestBuildNotifications(t *testing.T) {
+	occurrence := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+	alarms := []storedAlarm{
+		{id: "alarm-1", eventTime: occurrence, eventID: "event-1", data: []byte(storedEvent)},
+		{id: "alarm-2", eventTime: occurrence, eventID: "event-2", data: []byte("BEGIN:VCALENDAR\r\n")},
+	}
+	leased := map[string][]string{
+		"alarm-1": {"channel-1", "channel-2"},
+		"alarm-2": {"channel-1"},
+	}
+	channels := map[string]*pb.Channel{
+		"channel-1": {Id: "channel-1"},
+	}
+
+	notifications, undecodable := buildNotifications(alarms, leased, channels)
+
+	// Deleted channels are skipped, undecodable events don't hold up the others
+	require.Len(t, notifications, 1)
+	require.Equal(t, "alarm-1", notifications[0].Id)
+	require.Equal(t, "Daily Standup", notifications[0].Event.Summary)
+	require.Len(t, notifications[0].Channels, 1)
+	require.Equal(t, "channel-1", notifications[0].Channels[0].Id)
+
+	require.Len(t, undecodable, 1)
+	require.Contains(t, undecodable["alarm-2"], "decoding event event-2")
+}
+
+// insertAlarm stores an event with an alarm at alarmTime in a new calendar, and subscribes new channels to it.
+func insertAlarm(t *testing.T, db *sql.DB, alarmTime time.Time, channels int) (string, []string) {
+	t.Helper()
+
+	var calendarID, eventID, alarmID string
+
+	err := db.QueryRow(`insert into calendars (name, ical_url) values ('Team', '') returning id`).Scan(&calendarID)
+	require.NoError(t, err)
+
+	err = db.QueryRow(`
+		insert into calendar_events (calendar_id, uid, data) values ($1, 'standup@example.com', $2) returning id
+	`, calendarID, []byte(storedEvent)).Scan(&eventID)
+	require.NoError(t, err)
+
+	err = db.QueryRow(`
+		insert into calendar_event_alarms (event_id, alarm_time, event_time) values ($1, $2, $3) returning id
+	`, eventID, alarmTime, alarmTime.Add(15*time.Minute)).Scan(&alarmID)
+	require.NoError(t, err)
+
+	channelIDs := make([]string, 0, channels)
+
+	for range channels {
+		var channelID string
+
+		err := db.QueryRow(`insert into channels (type, data) values ('telegram', '{}') returning id`).Scan(&channelID)
+		require.NoError(t, err)
+
+		_, err = db.Exec(`insert into calendar_channels (calendar_id, channel_id) values ($1, $2)`, calendarID, channelID)
+		require.NoError(t, err)
+
+		channelIDs = append(channelIDs, channelID)
+	}
+
+	return alarmID, channelIDs
+}
+
+type delivery struct {
+	State       string
+	Attempts    int32
+	LeaseExpiry sql.Null[time.Time]
+	LastError   sql.Null[string]
+}
+
+func setDelivery(t *testing.T, db *sql.DB, alarmID, channelID string, d delivery) {
+	t.Helper()
+
+	_, err := db.Exec(`
+		insert into calendar_event_alarm_deliveries (alarm_id, channel_id, state, attempts, lease_expiry)
+		values ($1, $2, $3, $4, $5)
+		on conflict (alarm_id, channel_id) do update
+		set state = excluded.state, attempts = excluded.attempts, lease_expiry = excluded.lease_expiry
+	`, alarmID, channelID, d.State, d.Attempts, d.LeaseExpiry)
+	require.NoError(t, err)
+}
+
+func getDelivery(t *testing.T, db *sql.DB, alarmID, channelID string) delivery {
+	t.Helper()
+
+	var d delivery
+
+	err := db.QueryRow(`
+		select state, attempts, lease_expiry, last_error
+		from calendar_event_alarm_deliveries
+		where alarm_id = $1 and channel_id = $2
+	`, alarmID, channelID).Scan(&d.State, &d.Attempts, &d.LeaseExpiry, &d.LastError)
+	require.NoError(t, err)
+
+	// Times are read in the local time zone, the tests compare them in UTC
+	if d.LeaseExpiry.Valid {
+		d.LeaseExpiry.V = d.LeaseExpiry.V.UTC()
+	}
+
+	return d
+}
+
+// leasedChannels returns the ids of the channels that the notifications were leased for, by alarm id.
+func leasedChannels(notifications []*pb.EventNotification) map[string][]string {
+	leased := make(map[string][]string, len(notifications))
+
+	for _, notification := range notifications {
+		for _, ch := range notification.Channels {
+			leased[notification.Id] = append(leased[notification.Id], ch.Id)
+		}
+
+		slices.Sort(leased[notification.Id])
+	}
+
+	return leased
+}
+
+func TestRepository_LeaseDue(t *testing.T) {
+	db := databasetest.Postgres(t)
+	repo := NewRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	opts := LeaseOptions{Duration: time.Minute, MaxDelay: time.Hour, MaxAttempts: 3, Limit: 10}
+
+	due, dueChannels := insertAlarm(t, db, now.Add(-time.Minute), 2)
+	_, _ = insertAlarm(t, db, now.Add(time.Minute), 1)
+	_, _ = insertAlarm(t, db, now.Add(-2*time.Hour), 1)
+
+	notifications, err := repo.LeaseDue(context.Background(), now, opts)
+	require.NoError(t, err)
+
+	// Only the due alarm is delivered, alarms in the future and past MaxDelay are not
+	slices.Sort(dueChannels)
+	require.Equal(t, map[string][]string{due: dueChannels}, leasedChannels(notifications))
+
+	for _, channelID := range dueChannels {
+		require.Equal(t, delivery{
+			State:       StateInFlight,
+			Attempts:    1,
+			LeaseExpiry: sql.Null[time.Time]{V: now.Add(opts.Duration), Valid: true},
+		}, getDelivery(t, db, due, channelID))
+	}
+
+	// Leased deliveries aren't leased again before their lease expired
+	notifications, err = repo.LeaseDue(context.Background(), now.Add(opts.Duration/2), opts)
+	require.NoError(t, err)
+	require.Empty(t, notifications)
+}
+
+func TestRepository_LeaseDue_MaxAttempts(t *testing.T) {
+	db := databasetest.Postgres(t)
+	repo := NewRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	opts := LeaseOptions{Duration: time.Minute, MaxDelay: time.Hour, MaxAttempts: 3, Limit: 10}
+	expired := sql.Null[time.Time]{V: now.Add(-time.Second), Valid: true}
+
+	alarmID, channelIDs := insertAlarm(t, db, now.Add(-time.Minute), 2)
+	setDelivery(t, db, alarmID, channelIDs[0], delivery{State: StateInFlight, Attempts: 2, LeaseExpiry: expired})
+	setDelivery(t, db, alarmID, channelIDs[1], delivery{State: StateInFlight, Attempts: 3, LeaseExpiry: expired})
+
+	notifications, err := repo.LeaseDue(context.Background(), now, opts)
+	require.NoError(t, err)
+
+	// The expired lease is retried until the delivery was attempted MaxAttempts times
+	require.Equal(t, map[string][]string{alarmID: {channelIDs[0]}}, leasedChannels(notifications))
+	require.Equal(t, delivery{
+		State:       StateInFlight,
+		Attempts:    3,
+		LeaseExpiry: sql.Null[time.Time]{V: now.Add(opts.Duration), Valid: true},
+	}, getDelivery(t, db, alarmID, channelIDs[0]))
+	require.Equal(t, delivery{
+		State:     StateFailed,
+		Attempts:  3,
+		LastError: sql.Null[string]{V: errAckTimeout, Valid: true},
+	}, getDelivery(t, db, alarmID, channelIDs[1]))
+}
+
+func TestRepository_LeaseDue_SkipLocked(t *testing.T) {
+	db := databasetest.Postgres(t)
+	repo := NewRepository(db)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	opts := LeaseOptions{Duration: time.Minute, MaxDelay: time.Hour, MaxAttempts: 3, Limit: 10}
+
+	locked, lockedChannels := insertAlarm(t, db, now.Add(-2*time.Minute), 1)
+	free, freeChannels := insertAlarm(t, db, now.Add(-time.Minute), 1)
+
+	setDelivery(t, db, locked, lockedChannels[0], delivery{State: StatePending})
+
+	// Another stream is leasing the delivery right now
+	tx, err := db.BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`
+		select 1 from calendar_event_alarm_deliveries where alarm_id = $1 for update
+	`, locked)
+	require.NoError(t, err)
+
+	notifications, err := repo.LeaseDue(context.Background(), now, opts)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{free: freeChannels}, leasedChannels(notifications))
+
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, StatePending, getDelivery(t, db, locked, lockedChannels[0]).State)
+}
+
+func TestRepository_Acknowledge(t *testing.T) {
+	db := databasetest.Postgres(t)
+	repo := NewRepository(db)
+
+	now := time.Now()
+	lease := sql.Null[time.Time]{V: now.Add(time.Minute), Valid: true}
+
+	alarmID, channelIDs := insertAlarm(t, db, now.Add(-time.Minute), 5)
+	setDelivery(t, db, alarmID, channelIDs[0], delivery{State: StateInFlight, Attempts: 1, LeaseExpiry: lease})
+	setDelivery(t, db, alarmID, channelIDs[1], delivery{State: StateInFlight, Attempts: 1, LeaseExpiry: lease})
+	setDelivery(t, db, alarmID, channelIDs[2], delivery{State: StateInFlight, Attempts: 3, LeaseExpiry: lease})
+	// The lease of these deliveries expired before the late acknowledgement
+	setDelivery(t, db, alarmID, channelIDs[3], delivery{State: StateFailed, Attempts: 3})
+	setDelivery(t, db, alarmID, channelIDs[4], delivery{State: StatePending, Attempts: 1})
+
+	err := repo.Acknowledge(context.Background(), alarmID, channelIDs, []*pb.DeliveryFailure{
+		{ChannelId: channelIDs[1], Error: "chat not found"},
+		{ChannelId: channelIDs[2], Error: "chat not found"},
+	}, 3)
+	require.NoError(t, err)
+
+	require.Equal(t, StateDelivered, getDelivery(t, db, alarmID, channelIDs[0]).State)
+	require.Equal(t, delivery{
+		State:     StatePending,
+		Attempts:  1,
+		LastError: sql.Null[string]{V: "chat not found", Valid: true},
+	}, getDelivery(t, db, alarmID, channelIDs[1]))
+	require.Equal(t, delivery{
+		State:     StateFailed,
+		Attempts:  3,
+		LastError: sql.Null[string]{V: "chat not found", Valid: true},
+	}, getDelivery(t, db, alarmID, channelIDs[2]))
+	require.Equal(t, StateFailed, getDelivery(t, db, alarmID, channelIDs[3]).State)
+	require.Equal(t, StatePending, getDelivery(t, db, alarmID, channelIDs[4]).State)
+}
+
+func TestRepository_Release(t *testing.T) {
+	db := databasetest.Postgres(t)
+	repo := NewRepository(db)
+
+	now := time.Now()
+	lease := sql.Null[time.Time]{V: now.Add(time.Minute), Valid: true}
+
+	alarmID, channelIDs := insertAlarm(t, db, now.Add(-time.Minute), 2)
+	setDelivery(t, db, alarmID, channelIDs[0], delivery{State: StateInFlight, Attempts: 1, LeaseExpiry: lease})
+	setDelivery(t, db, alarmID, channelIDs[1], delivery{State: StateDelivered, Attempts: 1})
+
+	err := repo.Release(context.Background(), map[string][]string{alarmID: channelIDs})
+	require.NoError(t, err)
+
+	// Released deliveries keep their attempt, only leased ones are released
+	require.Equal(t, delivery{State: StatePending, Attempts: 1}, getDelivery(t, db, alarmID, channelIDs[0]))
+	require.Equal(t, StateDelivered, getDelivery(t, db, alarmID, channelIDs[1]).State)
+}
@@ -0,0 +1,247 @@
+package timezone
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-ical"
+)
+
+var ErrUnknownTimeZone = errors.New("unknown time zone")
+
+// Zones resolves the time zones of the date and time values in an iCal calendar. TZID parameters are looked up in
+// the IANA time zone database first and fall back to the VTIMEZONE components of the calendar. Floating times and
+// dates are interpreted in a default location.
+type Zones struct {
+	floating    *time.Location
+	definitions map[string]*ical.Component
+	locations   map[string]*time.Location
+}
+
+func New(cal *ical.Calendar, floating *time.Location) *Zones {
+	if floating == nil {
+		floating = time.UTC
+	}
+
+	zones := &Zones{
+		floating:    floating,
+		definitions: make(map[string]*ical.Component),
+		locations:   make(map[string]*time.Location),
+	}
+
+	for _, child := range cal.Children {
+		if child.Name != ical.CompTimezone {
+			continue
+		}
+
+		tzid, err := child.Props.Text(ical.PropTimezoneID)
+		if err != nil || tzid == "" {
+			continue
+		}
+
+		zones.definitions[tzid] = child
+	}
+
+	return zones
+}
+
+// Location returns the location identified by a TZID parameter.
+func (z *Zones) Location(tzid string) (*time.Location, error) {
+	if loc, ok := z.locations[tzid]; ok {
+		return loc, nil
+	}
+
+	loc, err := z.resolve(tzid)
+	if err != nil {
+		return nil, err
+	}
+
+	z.locations[tzid] = loc
+
+	return loc, nil
+}
+
+func (z *Zones) resolve(tzid string) (*time.Location, error) {
+	definition := z.definitions[tzid]
+
+	for _, name := range ianaCandidates(tzid, definition) {
+		loc, err := time.LoadLocation(name)
+		if err == nil {
+			return loc, nil
+		}
+	}
+
+	if definition == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownTimeZone, tzid)
+	}
+
+	loc, err := locationFromDefinition(tzid, definition)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrUnknownTimeZone, tzid, err)
+	}
+
+	return loc, nil
+}
+
+// ianaCandidates returns the IANA names a TZID may refer to. Besides the TZID itself, these are the location hint
+// some producers add to their VTIMEZONE, and the trailing "Area/Location" of TZIDs with a vendor prefix, like
+// "/mozilla.org/20050126_1/Europe/Berlin".
+func ianaCandidates(tzid string, definition *ical.Component) []string {
+	candidates := []string{tzid}
+
+	if definition != nil {
+		if location := definition.Props.Get("X-LIC-LOCATION"); location != nil {
+			candidates = append(candidates, location.Value)
+		}
+	}
+
+	segments := strings.Split(strings.Trim(tzid, "/"), "/")
+	for n := 3; n >= 2; n-- {
+		if len(segments) > n {
+			candidates = append(candidates, strings.Join(segments[len(segments)-n:], "/"))
+		}
+	}
+
+	// LoadLocation maps these to UTC and the server's time zone, neither is what the feed means
+	return slices.DeleteFunc(candidates, func(candidate string) bool {
+		return candidate == "" || candidate == "Local"
+	})
+}
+
+// DateTime returns the time of a DATE or DATE-TIME property.
+func (z *Zones) DateTime(prop *ical.Prop) (time.Time, error) {
+	loc := z.floating
+
+	tzid := prop.Params.Get(ical.PropTimezoneID)
+	if tzid != "" {
+		var err error
+
+		loc, err = z.Location(tzid)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	// Without the TZID, go-ical doesn't try to load the location itself
+	return withoutParam(prop, ical.PropTimezoneID).DateTime(loc)
+}
+
+// DateTimes returns the times of all properties with the given name, which may each contain a list of values.
+// Periods are represented by their start.
+func (z *Zones) DateTimes(props ical.Props, name string) ([]time.Time, error) {
+	var times []time.Time
+
+	for _, prop := range props[name] {
+		single := &prop
+		if prop.ValueType() == ical.ValuePeriod {
+			single = withoutParam(single, ical.ParamValue)
+		}
+
+		for _, value := range strings.Split(prop.Value, ",") {
+			value, _, _ = strings.Cut(value, "/")
+
+			single.Value = value
+
+			t, err := z.DateTime(single)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", name, err)
+			}
+
+			times = append(times, t)
+		}
+	}
+
+	return times, nil
+}
+
+// EventStart returns the start of the event, or the zero time if it has none.
+func (z *Zones) EventStart(event *ical.Event) (time.Time, error) {
+	prop := event.Props.Get(ical.PropDateTimeStart)
+	if prop == nil {
+		return time.Time{}, nil
+	}
+
+	return z.DateTime(prop)
+}
+
+// EventEnd returns the non-inclusive end of the event, the same way as ical.Event.DateTimeEnd.
+func (z *Zones) EventEnd(event *ical.Event) (time.Time, error) {
+	if prop := event.Props.Get(ical.PropDateTimeEnd); prop != nil {
+		return z.DateTime(prop)
+	}
+
+	startProp := event.Props.Get(ical.PropDateTimeStart)
+	if startProp == nil {
+		return time.Time{}, nil
+	}
+
+	start, err := z.DateTime(startProp)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if durationProp := event.Props.Get(ical.PropDuration); durationProp != nil {
+		duration, err := durationProp.Duration()
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return start.Add(duration), nil
+	}
+
+	if startProp.ValueType() == ical.ValueDate {
+		// A day isn't always 24 hours long in local time
+		return start.AddDate(0, 0, 1), nil
+	}
+
+	return start, nil
+}
+
+// Definitions returns the VTIMEZONE components referenced by the component and its children, ordered by TZID, so
+// they can be stored together with it.
+func (z *Zones) Definitions(comp *ical.Component) []*ical.Component {
+	tzids := make(map[string]bool)
+
+	var walk func(comp *ical.Component)
+
+	walk = func(comp *ical.Component) {
+		for _, props := range comp.Props {
+			for _, prop := range props {
+				if tzid := prop.Params.Get(ical.PropTimezoneID); tzid != "" {
+					tzids[tzid] = true
+				}
+			}
+		}
+
+		for _, child := range comp.Children {
+			walk(child)
+		}
+	}
+
+	walk(comp)
+
+	var definitions []*ical.Component
+
+	for _, tzid := range slices.Sorted(maps.Keys(tzids)) {
+		if definition, ok := z.definitions[tzid]; ok {
+			definitions = append(definitions, definition)
+		}
+	}
+
+	return definitions
+}
+
+func withoutParam(prop *ical.Prop, name string) *ical.Prop {
+	params := make(ical.Params, len(prop.Params))
+	for key, values := range prop.Params {
+		if key != name {
+			params[key] = values
+		}
+	}
+
+	return &ical.Prop{Name: prop.Name, Params: params, Value: prop.Value}
+}
@@ -0,0 +1,162 @@
+package timezone
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/stretchr/testify/require"
+)
+
+const timezoneFeed = `BEGIN:VCALENDAR
+PRODID:test
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:W. Europe Standard Time
+BEGIN:STANDARD
+DTSTART:16011028T030000
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=10
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+END:STANDARD
+BEGIN:DAYLIGHT
+DTSTART:16010325T020000
+RRULE:FREQ=YEARLY;BYDAY=-1SU;BYMONTH=3
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+END:DAYLIGHT
+END:VTIMEZONE
+BEGIN:VTIMEZONE
+TZID:/mozilla.org/20050126_1/America/New_York
+BEGIN:STANDARD
+DTSTART:19700101T000000
+TZOFFSETFROM:-0500
+TZOFFSETTO:-0500
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VTIMEZONE
+TZID:Fixed
+BEGIN:STANDARD
+DTSTART:16010101T000000
+TZOFFSETFROM:+0530
+TZOFFSETTO:+0530
+TZNAME:IST
+END:STANDARD
+END:VTIMEZONE
+END:VCALENDAR
+`
+
+func decodeFeed(t *testing.T) *ical.Calendar {
+	t.Helper()
+
+	cal, err := ical.NewDecoder(strings.NewReader(strings.ReplaceAll(timezoneFeed, "\n", "\r\n"))).Decode()
+	require.NoError(t, err)
+
+	return cal
+}
+
+func TestZones_DateTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	zones := New(decodeFeed(t), berlin)
+
+	testcases := []struct {
+		Name string
+
+		TZID  string
+		Value string
+
+		Expected    time.Time
+		ExpectedErr error
+	}{{
+		Name:     "IANA",
+		TZID:     "America/New_York",
+		Value:    "20250706T090000",
+		Expected: time.Date(2025, 7, 6, 13, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "Vendor prefix",
+		TZID:     "/mozilla.org/20050126_1/America/New_York",
+		Value:    "20250106T090000",
+		Expected: time.Date(2025, 1, 6, 14, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "VTIMEZONE in winter",
+		TZID:     "W. Europe Standard Time",
+		Value:    "20250328T090000",
+		Expected: time.Date(2025, 3, 28, 8, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "VTIMEZONE in summer",
+		TZID:     "W. Europe Standard Time",
+		Value:    "20250331T090000",
+		Expected: time.Date(2025, 3, 31, 7, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "VTIMEZONE without transitions",
+		TZID:     "Fixed",
+		Value:    "20250331T090000",
+		Expected: time.Date(2025, 3, 31, 3, 30, 0, 0, time.UTC),
+	}, {
+		Name:     "Floating",
+		Value:    "20250706T090000",
+		Expected: time.Date(2025, 7, 6, 7, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "UTC",
+		TZID:     "Fixed",
+		Value:    "20250706T090000Z",
+		Expected: time.Date(2025, 7, 6, 9, 0, 0, 0, time.UTC),
+	}, {
+		Name:     "Date",
+		Value:    "20250706",
+		Expected: time.Date(2025, 7, 5, 22, 0, 0, 0, time.UTC),
+	}, {
+		Name:        "Unknown",
+		TZID:        "Nowhere Standard Time",
+		Value:       "20250706T090000",
+		ExpectedErr: ErrUnknownTimeZone,
+	}}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.Name, func(t *testing.T) {
+			prop := ical.NewProp(ical.PropDateTimeStart)
+			prop.Value = testcase.Value
+
+			if testcase.TZID != "" {
+				prop.Params.Set(ical.PropTimezoneID, testcase.TZID)
+			}
+
+			if len(testcase.Value) == len("20060102") {
+				prop.SetValueType(ical.ValueDate)
+			}
+
+			actual, err := zones.DateTime(prop)
+			if testcase.ExpectedErr != nil {
+				require.ErrorIs(t, err, testcase.ExpectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, testcase.Expected.Equal(actual), "expected %s, got %s", testcase.Expected, actual)
+		})
+	}
+}
+
+func TestZones_Definitions(t *testing.T) {
+	zones := New(decodeFeed(t), nil)
+
+	event := ical.NewEvent()
+
+	start := ical.NewProp(ical.PropDateTimeStart)
+	start.Value = "20250706T090000"
+	start.Params.Set(ical.PropTimezoneID, "W. Europe Standard Time")
+	event.Props.Set(start)
+
+	exdate := ical.NewProp(ical.PropExceptionDates)
+	exdate.Value = "20250707T090000"
+	exdate.Params.Set(ical.PropTimezoneID, "Europe/Berlin")
+	event.Props.Set(exdate)
+
+	definitions := zones.Definitions(event.Component)
+	require.Len(t, definitions, 1)
+	require.Equal(t, "W. Europe Standard Time", definitions[0].Props.Get(ical.PropTimezoneID).Value)
+}
@@ -0,0 +1,294 @@
+package timezone
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/teambition/rrule-go"
+)
+
+var (
+	errNoObservances = errors.New("time zone has no observances")
+	errInvalidOffset = errors.New("invalid UTC offset")
+)
+
+// Transitions of a VTIMEZONE are only calculated for this range, times outside of it use the closest offset.
+var (
+	transitionsFrom  = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	transitionsUntil = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type zoneType struct {
+	offset int
+	isDST  bool
+	name   string
+}
+
+type transition struct {
+	at   time.Time
+	zone zoneType
+}
+
+// locationFromDefinition builds a location from the STANDARD and DAYLIGHT observances of a VTIMEZONE component.
+// The Go standard library can't construct locations with custom transitions, so they are encoded as TZif data.
+func locationFromDefinition(tzid string, definition *ical.Component) (*time.Location, error) {
+	var (
+		transitions []transition
+		// initial is the last transition before the calculated range, it is in effect at its start
+		initial *transition
+	)
+
+	for _, observance := range definition.Children {
+		if observance.Name != ical.CompTimezoneStandard && observance.Name != ical.CompTimezoneDaylight {
+			continue
+		}
+
+		offsetFrom, err := utcOffset(observance.Props.Get(ical.PropTimezoneOffsetFrom))
+		if err != nil {
+			return nil, err
+		}
+
+		offsetTo, err := utcOffset(observance.Props.Get(ical.PropTimezoneOffsetTo))
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := observance.Props.Text(ical.PropTimezoneName)
+		if err != nil || name == "" {
+			name = formatOffset(offsetTo)
+		}
+
+		zone := zoneType{offset: offsetTo, isDST: observance.Name == ical.CompTimezoneDaylight, name: name}
+
+		onsets, before, err := observanceOnsets(observance, offsetFrom)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, onset := range onsets {
+			transitions = append(transitions, transition{at: onset, zone: zone})
+		}
+
+		if !before.IsZero() && (initial == nil || before.After(initial.at)) {
+			initial = &transition{at: before, zone: zone}
+		}
+	}
+
+	if len(transitions) == 0 && initial == nil {
+		return nil, errNoObservances
+	}
+
+	slices.SortFunc(transitions, func(a, b transition) int {
+		return a.at.Compare(b.at)
+	})
+
+	if len(transitions) == 0 {
+		return time.FixedZone(initial.zone.name, initial.zone.offset), nil
+	}
+
+	if initial == nil {
+		initial = &transition{zone: zoneType{offset: transitions[0].zone.offset, name: transitions[0].zone.name}}
+	}
+
+	return time.LoadLocationFromTZData(tzid, encodeTZif(initial.zone, transitions))
+}
+
+// observanceOnsets returns the instants within the calculated range at which the observance starts to be in effect,
+// and the last one before the range. Onsets are given in local time, in the offset in effect before them.
+func observanceOnsets(observance *ical.Component, offsetFrom int) ([]time.Time, time.Time, error) {
+	// Local times are handled as if they were UTC and shifted by the offset afterward
+	start, err := observance.Props.DateTime(ical.PropDateTimeStart, time.UTC)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	local := []time.Time{start}
+
+	for _, prop := range observance.Props.Values(ical.PropRecurrenceDates) {
+		rdate, err := prop.DateTime(time.UTC)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		local = append(local, rdate)
+	}
+
+	shift := time.Duration(offsetFrom) * time.Second
+	rangeFrom, rangeUntil := transitionsFrom.Add(shift), transitionsUntil.Add(shift)
+
+	var before time.Time
+
+	ruleOptions, err := observance.Props.RecurrenceRule()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if ruleOptions != nil {
+		ruleOptions.Dtstart = start
+
+		// The rrule iterator gives up on rules that start centuries before the range, as some producers do. For the
+		// usual yearly rules, starting shortly before the range yields the same onsets.
+		if start.Year() < rangeFrom.Year()-1 && ruleOptions.Freq == rrule.YEARLY && ruleOptions.Count == 0 &&
+			ruleOptions.Interval <= 1 {
+			ruleOptions.Dtstart = start.AddDate(rangeFrom.Year()-1-start.Year(), 0, 0)
+		}
+		if !ruleOptions.Until.IsZero() {
+			// UNTIL is given in UTC
+			ruleOptions.Until = ruleOptions.Until.Add(shift)
+		}
+
+		rule, err := rrule.NewRRule(*ruleOptions)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		local = append(local, rule.Between(rangeFrom, rangeUntil, true)...)
+		before = rule.Before(rangeFrom, false)
+	}
+
+	var onsets []time.Time
+
+	for _, t := range local {
+		switch {
+		case t.Before(rangeFrom):
+			if t.After(before) {
+				before = t
+			}
+		case t.Before(rangeUntil):
+			onsets = append(onsets, t.Add(-shift))
+		}
+	}
+
+	if !before.IsZero() {
+		before = before.Add(-shift)
+	}
+
+	slices.SortFunc(onsets, time.Time.Compare)
+
+	return slices.CompactFunc(onsets, time.Time.Equal), before, nil
+}
+
+// utcOffset parses a UTC-OFFSET value like "+0100" or "-033000" into seconds east of UTC.
+func utcOffset(prop *ical.Prop) (int, error) {
+	if prop == nil {
+		return 0, errInvalidOffset
+	}
+
+	value := prop.Value
+	if len(value) != 5 && len(value) != 7 || value[0] != '+' && value[0] != '-' {
+		return 0, fmt.Errorf("%w: %q", errInvalidOffset, value)
+	}
+
+	var parts [3]int
+
+	for i := 0; 1+2*i < len(value); i++ {
+		part, err := strconv.Atoi(value[1+2*i : 3+2*i])
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", errInvalidOffset, value)
+		}
+
+		parts[i] = part
+	}
+
+	offset := parts[0]*3600 + parts[1]*60 + parts[2]
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset%3600/60)
+}
+
+// encodeTZif encodes the transitions as version 2 TZif data (RFC 8536). The initial zone is in effect before the
+// first transition.
+func encodeTZif(initial zoneType, transitions []transition) []byte {
+	zones := []zoneType{initial}
+	zoneIndex := make(map[zoneType]int)
+
+	indices := make([]byte, 0, len(transitions))
+
+	for _, t := range transitions {
+		index, ok := zoneIndex[t.zone]
+		if !ok {
+			index = len(zones)
+			zoneIndex[t.zone] = index
+			zones = append(zones, t.zone)
+		}
+
+		indices = append(indices, byte(index))
+	}
+
+	var (
+		names     []byte
+		nameIndex = make(map[string]int)
+	)
+
+	for _, zone := range zones {
+		if _, ok := nameIndex[zone.name]; !ok {
+			nameIndex[zone.name] = len(names)
+			names = append(append(names, zone.name...), 0)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	writeHeader := func(transitionCount, zoneCount, nameLength int) {
+		buf.WriteString("TZif2")
+		buf.Write(make([]byte, 15))
+
+		for _, count := range []int{0, 0, 0, transitionCount, zoneCount, nameLength} {
+			_ = binary.Write(&buf, binary.BigEndian, uint32(count))
+		}
+	}
+
+	writeZone := func(zone zoneType) {
+		_ = binary.Write(&buf, binary.BigEndian, int32(zone.offset))
+
+		isDST := byte(0)
+		if zone.isDST {
+			isDST = 1
+		}
+
+		buf.WriteByte(isDST)
+		buf.WriteByte(byte(nameIndex[zone.name]))
+	}
+
+	// The version 1 data block is only read by legacy readers, so it just contains the initial zone
+	writeHeader(0, 1, len(names))
+	writeZone(initial)
+	buf.Write(names)
+
+	writeHeader(len(transitions), len(zones), len(names))
+
+	for _, t := range transitions {
+		_ = binary.Write(&buf, binary.BigEndian, t.at.Unix())
+	}
+
+	buf.Write(indices)
+
+	for _, zone := range zones {
+		writeZone(zone)
+	}
+
+	buf.Write(names)
+
+	// Empty footer, times after the last transition use its zone
+	buf.WriteString("\n\n")
+
+	return buf.Bytes()
+}
@@ -230,10 +230,15 @@ func (x *ListCalendarsRequest) GetFilter() *ListCalendarsFilter {
 }
 
 type ListCalendarsFilter struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return calendars that weren't synced since this time, including those that were never synced
 	LastSyncTimeBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_sync_time_before,proto3" json:"last_sync_time_before,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Only return calendars that are due for a sync at this time
+	NextSyncTimeBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=next_sync_time_before,proto3" json:"next_sync_time_before,omitempty"`
+	// Only return calendars the channel is subscribed to
+	ChannelId     string `protobuf:"bytes,4,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCalendarsFilter) Reset() {
@@ -273,6 +278,20 @@ func (x *ListCalendarsFilter) GetLastSyncTimeBefore() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListCalendarsFilter) GetNextSyncTimeBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSyncTimeBefore
+	}
+	return nil
+}
+
+func (x *ListCalendarsFilter) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
 type ListCalendarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Calendars     []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
@@ -421,34 +440,27 @@ func (x *DeleteCalendarRequest) GetId() string {
 	return ""
 }
 
-type Calendar struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IcalUrl             string                 `protobuf:"bytes,3,opt,name=ical_url,proto3" json:"ical_url,omitempty"`
-	LastSyncTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync_time,proto3" json:"last_sync_time,omitempty"`
-	DefaultReminders    []*DefaultReminder     `protobuf:"bytes,5,rep,name=default_reminders,proto3" json:"default_reminders,omitempty"`
-	DefaultReminderMode DefaultReminderMode    `protobuf:"varint,6,opt,name=default_reminder_mode,proto3,enum=ical_bot_backend.v1.DefaultReminderMode" json:"default_reminder_mode,omitempty"`
-	LastSyncHash        []byte                 `protobuf:"bytes,7,opt,name=last_sync_hash,proto3" json:"last_sync_hash,omitempty"`
-	LastSyncError       *status.Status         `protobuf:"bytes,8,opt,name=last_sync_error,json=last_import_error,proto3" json:"last_sync_error,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type SyncCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Calendar) Reset() {
-	*x = Calendar{}
+func (x *SyncCalendarRequest) Reset() {
+	*x = SyncCalendarRequest{}
 	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Calendar) String() string {
+func (x *SyncCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Calendar) ProtoMessage() {}
+func (*SyncCalendarRequest) ProtoMessage() {}
 
-func (x *Calendar) ProtoReflect() protoreflect.Message {
+func (x *SyncCalendarRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,90 +472,115 @@ func (x *Calendar) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
-func (*Calendar) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SyncCalendarRequest) Descriptor() ([]byte, []int) {
 	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Calendar) GetId() string {
+func (x *SyncCalendarRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Calendar) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type SyncCalendarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the feed didn't change since the last sync, in which case its events weren't imported again
+	Unchanged      bool  `protobuf:"varint,1,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	EventsImported int32 `protobuf:"varint,2,opt,name=events_imported,proto3" json:"events_imported,omitempty"`
+	// Number of alarms scheduled for the imported events within the alarm horizon
+	AlarmsScheduled int32 `protobuf:"varint,3,opt,name=alarms_scheduled,proto3" json:"alarms_scheduled,omitempty"`
+	// Reason of a failed import, which is also stored as the last_sync_error of the calendar
+	Error         *status.Status `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Calendar) GetIcalUrl() string {
-	if x != nil {
-		return x.IcalUrl
-	}
-	return ""
+func (x *SyncCalendarResponse) Reset() {
+	*x = SyncCalendarResponse{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Calendar) GetLastSyncTime() *timestamppb.Timestamp {
+func (x *SyncCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCalendarResponse) ProtoMessage() {}
+
+func (x *SyncCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[8]
 	if x != nil {
-		return x.LastSyncTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Calendar) GetDefaultReminders() []*DefaultReminder {
+// Deprecated: Use SyncCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SyncCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SyncCalendarResponse) GetUnchanged() bool {
 	if x != nil {
-		return x.DefaultReminders
+		return x.Unchanged
 	}
-	return nil
+	return false
 }
 
-func (x *Calendar) GetDefaultReminderMode() DefaultReminderMode {
+func (x *SyncCalendarResponse) GetEventsImported() int32 {
 	if x != nil {
-		return x.DefaultReminderMode
+		return x.EventsImported
 	}
-	return DefaultReminderMode_DEFAULT_REMINDER_MODE_UNKNOWN
+	return 0
 }
 
-func (x *Calendar) GetLastSyncHash() []byte {
+func (x *SyncCalendarResponse) GetAlarmsScheduled() int32 {
 	if x != nil {
-		return x.LastSyncHash
+		return x.AlarmsScheduled
 	}
-	return nil
+	return 0
 }
 
-func (x *Calendar) GetLastSyncError() *status.Status {
+func (x *SyncCalendarResponse) GetError() *status.Status {
 	if x != nil {
-		return x.LastSyncError
+		return x.Error
 	}
 	return nil
 }
 
-type DefaultReminder struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Before        *durationpb.Duration   `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+type ListCalendarImportsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,proto3" json:"calendar_id,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken  string                 `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// Only return imports of a changed feed, skipping unchanged and failed ones
+	ChangedOnly   bool `protobuf:"varint,4,opt,name=changed_only,proto3" json:"changed_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DefaultReminder) Reset() {
-	*x = DefaultReminder{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[8]
+func (x *ListCalendarImportsRequest) Reset() {
+	*x = ListCalendarImportsRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DefaultReminder) String() string {
+func (x *ListCalendarImportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultReminder) ProtoMessage() {}
+func (*ListCalendarImportsRequest) ProtoMessage() {}
 
-func (x *DefaultReminder) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[8]
+func (x *ListCalendarImportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,47 +591,62 @@ func (x *DefaultReminder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultReminder.ProtoReflect.Descriptor instead.
-func (*DefaultReminder) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListCalendarImportsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarImportsRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DefaultReminder) GetId() string {
+func (x *ListCalendarImportsRequest) GetCalendarId() string {
 	if x != nil {
-		return x.Id
+		return x.CalendarId
 	}
 	return ""
 }
 
-func (x *DefaultReminder) GetBefore() *durationpb.Duration {
+func (x *ListCalendarImportsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Before
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type GetChannelRequest struct {
+func (x *ListCalendarImportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCalendarImportsRequest) GetChangedOnly() bool {
+	if x != nil {
+		return x.ChangedOnly
+	}
+	return false
+}
+
+type ListCalendarImportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Imports       []*CalendarImport      `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChannelRequest) Reset() {
-	*x = GetChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[9]
+func (x *ListCalendarImportsResponse) Reset() {
+	*x = ListCalendarImportsResponse{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChannelRequest) String() string {
+func (x *ListCalendarImportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChannelRequest) ProtoMessage() {}
+func (*ListCalendarImportsResponse) ProtoMessage() {}
 
-func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[9]
+func (x *ListCalendarImportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,41 +657,62 @@ func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChannelRequest.ProtoReflect.Descriptor instead.
-func (*GetChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListCalendarImportsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarImportsResponse) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetChannelRequest) GetId() string {
+func (x *ListCalendarImportsResponse) GetImports() []*CalendarImport {
 	if x != nil {
-		return x.Id
+		return x.Imports
+	}
+	return nil
+}
+
+func (x *ListCalendarImportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type ListChannelsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+// CalendarImport is a single run of the import of a calendar. Imports are kept for the configured history retention.
+type CalendarImport struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CalendarId string                 `protobuf:"bytes,2,opt,name=calendar_id,proto3" json:"calendar_id,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// Status code of the response of the feed host, unset for sources other than HTTP
+	HttpStatus   int32 `protobuf:"varint,5,opt,name=http_status,proto3" json:"http_status,omitempty"`
+	BytesFetched int64 `protobuf:"varint,6,opt,name=bytes_fetched,proto3" json:"bytes_fetched,omitempty"`
+	// Whether the feed didn't change since the previous import, in which case its events were kept as they are
+	Unchanged       bool  `protobuf:"varint,7,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	EventsAdded     int32 `protobuf:"varint,8,opt,name=events_added,proto3" json:"events_added,omitempty"`
+	EventsUpdated   int32 `protobuf:"varint,9,opt,name=events_updated,proto3" json:"events_updated,omitempty"`
+	EventsRemoved   int32 `protobuf:"varint,10,opt,name=events_removed,proto3" json:"events_removed,omitempty"`
+	AlarmsScheduled int32 `protobuf:"varint,11,opt,name=alarms_scheduled,proto3" json:"alarms_scheduled,omitempty"`
+	// Reason of a failed import
+	Error         *status.Status `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChannelsRequest) Reset() {
-	*x = ListChannelsRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[10]
+func (x *CalendarImport) Reset() {
+	*x = CalendarImport{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChannelsRequest) String() string {
+func (x *CalendarImport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChannelsRequest) ProtoMessage() {}
+func (*CalendarImport) ProtoMessage() {}
 
-func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[10]
+func (x *CalendarImport) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,48 +723,119 @@ func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CalendarImport.ProtoReflect.Descriptor instead.
+func (*CalendarImport) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListChannelsRequest) GetPageSize() int32 {
+func (x *CalendarImport) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListChannelsRequest) GetPageToken() string {
+func (x *CalendarImport) GetCalendarId() string {
 	if x != nil {
-		return x.PageToken
+		return x.CalendarId
 	}
 	return ""
 }
 
-type ListChannelsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CalendarImport) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *ListChannelsResponse) Reset() {
-	*x = ListChannelsResponse{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[11]
+func (x *CalendarImport) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CalendarImport) GetHttpStatus() int32 {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetBytesFetched() int64 {
+	if x != nil {
+		return x.BytesFetched
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
+func (x *CalendarImport) GetEventsAdded() int32 {
+	if x != nil {
+		return x.EventsAdded
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetEventsUpdated() int32 {
+	if x != nil {
+		return x.EventsUpdated
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetEventsRemoved() int32 {
+	if x != nil {
+		return x.EventsRemoved
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetAlarmsScheduled() int32 {
+	if x != nil {
+		return x.AlarmsScheduled
+	}
+	return 0
+}
+
+func (x *CalendarImport) GetError() *status.Status {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type PreviewCalendarRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Calendar *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	// Maximum number of alarms returned per event, 5 if unset, at most 100
+	AlarmsPerEvent int32 `protobuf:"varint,2,opt,name=alarms_per_event,proto3" json:"alarms_per_event,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewCalendarRequest) Reset() {
+	*x = PreviewCalendarRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChannelsResponse) String() string {
+func (x *PreviewCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChannelsResponse) ProtoMessage() {}
+func (*PreviewCalendarRequest) ProtoMessage() {}
 
-func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[11]
+func (x *PreviewCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,47 +846,51 @@ func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PreviewCalendarRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListChannelsResponse) GetChannels() []*Channel {
+func (x *PreviewCalendarRequest) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Channels
+		return x.Calendar
 	}
 	return nil
 }
 
-func (x *ListChannelsResponse) GetNextPageToken() string {
+func (x *PreviewCalendarRequest) GetAlarmsPerEvent() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.AlarmsPerEvent
 	}
-	return ""
+	return 0
 }
 
-type CreateChannelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+type PreviewCalendarResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*PreviewEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Number of single events that ended already. They have no future alarms and aren't imported.
+	PastEvents int32 `protobuf:"varint,2,opt,name=past_events,proto3" json:"past_events,omitempty"`
+	// Parts of the feed that are skipped or not supported, and events that would fail the import
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[12]
+func (x *PreviewCalendarResponse) Reset() {
+	*x = PreviewCalendarResponse{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *PreviewCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*PreviewCalendarResponse) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[12]
+func (x *PreviewCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,9 +901,1090 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PreviewCalendarResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreviewCalendarResponse) GetEvents() []*PreviewEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *PreviewCalendarResponse) GetPastEvents() int32 {
+	if x != nil {
+		return x.PastEvents
+	}
+	return 0
+}
+
+func (x *PreviewCalendarResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type PreviewEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uid   string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Set for events that override an occurrence of a recurring event
+	RecurrenceId *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=recurrence_id,proto3" json:"recurrence_id,omitempty"`
+	// The first occurrence of the event
+	Event         *Event          `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	AllDay        bool            `protobuf:"varint,4,opt,name=all_day,proto3" json:"all_day,omitempty"`
+	Recurring     bool            `protobuf:"varint,5,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	NextAlarms    []*PreviewAlarm `protobuf:"bytes,6,rep,name=next_alarms,proto3" json:"next_alarms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewEvent) Reset() {
+	*x = PreviewEvent{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewEvent) ProtoMessage() {}
+
+func (x *PreviewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewEvent.ProtoReflect.Descriptor instead.
+func (*PreviewEvent) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PreviewEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *PreviewEvent) GetRecurrenceId() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return nil
+}
+
+func (x *PreviewEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PreviewEvent) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *PreviewEvent) GetRecurring() bool {
+	if x != nil {
+		return x.Recurring
+	}
+	return false
+}
+
+func (x *PreviewEvent) GetNextAlarms() []*PreviewAlarm {
+	if x != nil {
+		return x.NextAlarms
+	}
+	return nil
+}
+
+type PreviewAlarm struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AlarmTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=alarm_time,proto3" json:"alarm_time,omitempty"`
+	// Start of the occurrence the alarm is for
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=event_time,proto3" json:"event_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewAlarm) Reset() {
+	*x = PreviewAlarm{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewAlarm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAlarm) ProtoMessage() {}
+
+func (x *PreviewAlarm) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAlarm.ProtoReflect.Descriptor instead.
+func (*PreviewAlarm) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreviewAlarm) GetAlarmTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AlarmTime
+	}
+	return nil
+}
+
+func (x *PreviewAlarm) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+type ImportCalendarDataRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CalendarId string                 `protobuf:"bytes,1,opt,name=calendar_id,proto3" json:"calendar_id,omitempty"`
+	// iCal data, e.g. an exported .ics file
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCalendarDataRequest) Reset() {
+	*x = ImportCalendarDataRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarDataRequest) ProtoMessage() {}
+
+func (x *ImportCalendarDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarDataRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportCalendarDataRequest) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *ImportCalendarDataRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Calendar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// URL of the iCal feed of calendars with an HTTP source. Setting it without a source selects the HTTP source, for
+	// compatibility with clients that predate the source field.
+	IcalUrl             string                 `protobuf:"bytes,3,opt,name=ical_url,proto3" json:"ical_url,omitempty"`
+	LastSyncTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync_time,proto3" json:"last_sync_time,omitempty"`
+	DefaultReminders    []*DefaultReminder     `protobuf:"bytes,5,rep,name=default_reminders,proto3" json:"default_reminders,omitempty"`
+	DefaultReminderMode DefaultReminderMode    `protobuf:"varint,6,opt,name=default_reminder_mode,proto3,enum=ical_bot_backend.v1.DefaultReminderMode" json:"default_reminder_mode,omitempty"`
+	LastSyncHash        []byte                 `protobuf:"bytes,7,opt,name=last_sync_hash,proto3" json:"last_sync_hash,omitempty"`
+	LastSyncError       *status.Status         `protobuf:"bytes,8,opt,name=last_sync_error,json=last_import_error,proto3" json:"last_sync_error,omitempty"`
+	// Output only. Cache validators returned by the feed host on the last sync, sent back as conditional request headers.
+	HttpEtag         string `protobuf:"bytes,9,opt,name=http_etag,proto3" json:"http_etag,omitempty"`
+	HttpLastModified string `protobuf:"bytes,10,opt,name=http_last_modified,proto3" json:"http_last_modified,omitempty"`
+	// IANA time zone, e.g. "Europe/Berlin", of floating times and all-day events in the feed. Defaults to UTC.
+	TimeZone string `protobuf:"bytes,11,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	// Reminders for all-day events at a wall-clock time in the calendar's time zone. All-day events use the default
+	// reminders if there are none.
+	AllDayReminders []*AllDayReminder `protobuf:"bytes,12,rep,name=all_day_reminders,proto3" json:"all_day_reminders,omitempty"`
+	// Credentials sent when fetching the feed. They are stored encrypted and never returned, see has_credentials.
+	Credentials *FeedCredentials `protobuf:"bytes,13,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// Output only. Whether credentials are stored for the feed.
+	HasCredentials bool `protobuf:"varint,14,opt,name=has_credentials,proto3" json:"has_credentials,omitempty"`
+	// Where the events of the calendar are imported from. Credentials are used by the HTTP and CalDAV sources.
+	//
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Calendar_Http
+	//	*Calendar_Caldav
+	//	*Calendar_File
+	//	*Calendar_Inline
+	Source isCalendar_Source `protobuf_oneof:"source"`
+	// Time between two syncs of the calendar, at least one minute. Defaults to the configured sync interval.
+	SyncInterval *durationpb.Duration `protobuf:"bytes,19,opt,name=sync_interval,proto3" json:"sync_interval,omitempty"`
+	// Output only. When the calendar is synced next. After failed imports, the sync is delayed with an exponential
+	// backoff, or as long as the feed host asked for with a Retry-After header, up to a configured maximum.
+	NextSyncTime *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=next_sync_time,proto3" json:"next_sync_time,omitempty"`
+	// Output only. Number of failed imports since the last successful one.
+	SyncFailures int32 `protobuf:"varint,21,opt,name=sync_failures,proto3" json:"sync_failures,omitempty"`
+	// Maximum size of the feed in bytes, for feeds that are larger than the configured limit. Defaults to the
+	// configured limit if unset and must not exceed the configured maximum.
+	MaxIcalSize   int64 `protobuf:"varint,22,opt,name=max_ical_size,proto3" json:"max_ical_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Calendar) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Calendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Calendar) GetIcalUrl() string {
+	if x != nil {
+		return x.IcalUrl
+	}
+	return ""
+}
+
+func (x *Calendar) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *Calendar) GetDefaultReminders() []*DefaultReminder {
+	if x != nil {
+		return x.DefaultReminders
+	}
+	return nil
+}
+
+func (x *Calendar) GetDefaultReminderMode() DefaultReminderMode {
+	if x != nil {
+		return x.DefaultReminderMode
+	}
+	return DefaultReminderMode_DEFAULT_REMINDER_MODE_UNKNOWN
+}
+
+func (x *Calendar) GetLastSyncHash() []byte {
+	if x != nil {
+		return x.LastSyncHash
+	}
+	return nil
+}
+
+func (x *Calendar) GetLastSyncError() *status.Status {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return nil
+}
+
+func (x *Calendar) GetHttpEtag() string {
+	if x != nil {
+		return x.HttpEtag
+	}
+	return ""
+}
+
+func (x *Calendar) GetHttpLastModified() string {
+	if x != nil {
+		return x.HttpLastModified
+	}
+	return ""
+}
+
+func (x *Calendar) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Calendar) GetAllDayReminders() []*AllDayReminder {
+	if x != nil {
+		return x.AllDayReminders
+	}
+	return nil
+}
+
+func (x *Calendar) GetCredentials() *FeedCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *Calendar) GetHasCredentials() bool {
+	if x != nil {
+		return x.HasCredentials
+	}
+	return false
+}
+
+func (x *Calendar) GetSource() isCalendar_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Calendar) GetHttp() *HTTPSource {
+	if x != nil {
+		if x, ok := x.Source.(*Calendar_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+func (x *Calendar) GetCaldav() *CalDAVSource {
+	if x != nil {
+		if x, ok := x.Source.(*Calendar_Caldav); ok {
+			return x.Caldav
+		}
+	}
+	return nil
+}
+
+func (x *Calendar) GetFile() *FileSource {
+	if x != nil {
+		if x, ok := x.Source.(*Calendar_File); ok {
+			return x.File
+		}
+	}
+	return nil
+}
+
+func (x *Calendar) GetInline() *InlineSource {
+	if x != nil {
+		if x, ok := x.Source.(*Calendar_Inline); ok {
+			return x.Inline
+		}
+	}
+	return nil
+}
+
+func (x *Calendar) GetSyncInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SyncInterval
+	}
+	return nil
+}
+
+func (x *Calendar) GetNextSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextSyncTime
+	}
+	return nil
+}
+
+func (x *Calendar) GetSyncFailures() int32 {
+	if x != nil {
+		return x.SyncFailures
+	}
+	return 0
+}
+
+func (x *Calendar) GetMaxIcalSize() int64 {
+	if x != nil {
+		return x.MaxIcalSize
+	}
+	return 0
+}
+
+type isCalendar_Source interface {
+	isCalendar_Source()
+}
+
+type Calendar_Http struct {
+	Http *HTTPSource `protobuf:"bytes,16,opt,name=http,proto3,oneof"`
+}
+
+type Calendar_Caldav struct {
+	Caldav *CalDAVSource `protobuf:"bytes,15,opt,name=caldav,proto3,oneof"`
+}
+
+type Calendar_File struct {
+	File *FileSource `protobuf:"bytes,17,opt,name=file,proto3,oneof"`
+}
+
+type Calendar_Inline struct {
+	Inline *InlineSource `protobuf:"bytes,18,opt,name=inline,proto3,oneof"`
+}
+
+func (*Calendar_Http) isCalendar_Source() {}
+
+func (*Calendar_Caldav) isCalendar_Source() {}
+
+func (*Calendar_File) isCalendar_Source() {}
+
+func (*Calendar_Inline) isCalendar_Source() {}
+
+// HTTPSource is an iCal feed fetched over HTTP(S). webcal and webcals URLs are supported as well.
+type HTTPSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPSource) Reset() {
+	*x = HTTPSource{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPSource) ProtoMessage() {}
+
+func (x *HTTPSource) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPSource.ProtoReflect.Descriptor instead.
+func (*HTTPSource) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HTTPSource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// CalDAVSource is a calendar collection on a CalDAV server, e.g. "https://cloud.example.com/remote.php/dav/calendars/alice/team/".
+type CalDAVSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionUrl string                 `protobuf:"bytes,1,opt,name=collection_url,proto3" json:"collection_url,omitempty"`
+	// Output only. Versions of the collection on the last sync, used to skip unchanged collections. Changed collections
+	// are fetched in full.
+	SyncToken     string `protobuf:"bytes,2,opt,name=sync_token,proto3" json:"sync_token,omitempty"`
+	Ctag          string `protobuf:"bytes,3,opt,name=ctag,proto3" json:"ctag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalDAVSource) Reset() {
+	*x = CalDAVSource{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalDAVSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalDAVSource) ProtoMessage() {}
+
+func (x *CalDAVSource) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalDAVSource.ProtoReflect.Descriptor instead.
+func (*CalDAVSource) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CalDAVSource) GetCollectionUrl() string {
+	if x != nil {
+		return x.CollectionUrl
+	}
+	return ""
+}
+
+func (x *CalDAVSource) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+func (x *CalDAVSource) GetCtag() string {
+	if x != nil {
+		return x.Ctag
+	}
+	return ""
+}
+
+// FeedCredentials authenticate the requests for a private feed.
+type FeedCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Authorization:
+	//
+	//	*FeedCredentials_BasicAuth_
+	//	*FeedCredentials_BearerToken
+	Authorization isFeedCredentials_Authorization `protobuf_oneof:"authorization"`
+	// Additional request headers, e.g. API keys
+	Headers       map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedCredentials) Reset() {
+	*x = FeedCredentials{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedCredentials) ProtoMessage() {}
+
+func (x *FeedCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedCredentials.ProtoReflect.Descriptor instead.
+func (*FeedCredentials) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedCredentials) GetAuthorization() isFeedCredentials_Authorization {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
+func (x *FeedCredentials) GetBasicAuth() *FeedCredentials_BasicAuth {
+	if x != nil {
+		if x, ok := x.Authorization.(*FeedCredentials_BasicAuth_); ok {
+			return x.BasicAuth
+		}
+	}
+	return nil
+}
+
+func (x *FeedCredentials) GetBearerToken() string {
+	if x != nil {
+		if x, ok := x.Authorization.(*FeedCredentials_BearerToken); ok {
+			return x.BearerToken
+		}
+	}
+	return ""
+}
+
+func (x *FeedCredentials) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type isFeedCredentials_Authorization interface {
+	isFeedCredentials_Authorization()
+}
+
+type FeedCredentials_BasicAuth_ struct {
+	BasicAuth *FeedCredentials_BasicAuth `protobuf:"bytes,1,opt,name=basic_auth,proto3,oneof"`
+}
+
+type FeedCredentials_BearerToken struct {
+	BearerToken string `protobuf:"bytes,2,opt,name=bearer_token,proto3,oneof"`
+}
+
+func (*FeedCredentials_BasicAuth_) isFeedCredentials_Authorization() {}
+
+func (*FeedCredentials_BearerToken) isFeedCredentials_Authorization() {}
+
+type DefaultReminder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Assigned by the server whenever the reminders of the calendar are set.
+	Id            string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Before        *durationpb.Duration `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultReminder) Reset() {
+	*x = DefaultReminder{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultReminder) ProtoMessage() {}
+
+func (x *DefaultReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultReminder.ProtoReflect.Descriptor instead.
+func (*DefaultReminder) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DefaultReminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DefaultReminder) GetBefore() *durationpb.Duration {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// AllDayReminder reminds of an all-day event at a time of day, e.g. at 08:00 on the day or at 18:00 the day before.
+type AllDayReminder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Output only. Assigned by the server whenever the reminders of the calendar are set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of days before the day of the event, 0 is the day itself
+	DaysBefore int32 `protobuf:"varint,2,opt,name=days_before,proto3" json:"days_before,omitempty"`
+	// Time of day of the reminder, as the duration since midnight
+	TimeOfDay     *durationpb.Duration `protobuf:"bytes,3,opt,name=time_of_day,proto3" json:"time_of_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllDayReminder) Reset() {
+	*x = AllDayReminder{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllDayReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllDayReminder) ProtoMessage() {}
+
+func (x *AllDayReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllDayReminder.ProtoReflect.Descriptor instead.
+func (*AllDayReminder) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AllDayReminder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AllDayReminder) GetDaysBefore() int32 {
+	if x != nil {
+		return x.DaysBefore
+	}
+	return 0
+}
+
+func (x *AllDayReminder) GetTimeOfDay() *durationpb.Duration {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return nil
+}
+
+// FileSource is an iCal file, or a directory of .ics files, on a file system of the server, e.g. a mounted share. The
+// path is relative to the directory configured for file sources.
+type FileSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileSource) Reset() {
+	*x = FileSource{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSource) ProtoMessage() {}
+
+func (x *FileSource) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSource.ProtoReflect.Descriptor instead.
+func (*FileSource) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FileSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// InlineSource is iCal data stored with the calendar, e.g. a one-off export.
+type InlineSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Input only. The data isn't returned, as it may be large.
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InlineSource) Reset() {
+	*x = InlineSource{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InlineSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InlineSource) ProtoMessage() {}
+
+func (x *InlineSource) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InlineSource.ProtoReflect.Descriptor instead.
+func (*InlineSource) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InlineSource) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelRequest) Reset() {
+	*x = GetChannelRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelRequest) ProtoMessage() {}
+
+func (x *GetChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetChannelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRequest) ProtoMessage() {}
+
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListChannelsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChannelsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsResponse) ProtoMessage() {}
+
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ListChannelsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateChannelRequest) GetChannel() *Channel {
@@ -775,7 +2004,7 @@ type UpdateChannelRequest struct {
 
 func (x *UpdateChannelRequest) Reset() {
 	*x = UpdateChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[13]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +2016,7 @@ func (x *UpdateChannelRequest) String() string {
 func (*UpdateChannelRequest) ProtoMessage() {}
 
 func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[13]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +2029,7 @@ func (x *UpdateChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateChannelRequest.ProtoReflect.Descriptor instead.
 func (*UpdateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{13}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateChannelRequest) GetChannel() *Channel {
@@ -826,7 +2055,7 @@ type DeleteChannelRequest struct {
 
 func (x *DeleteChannelRequest) Reset() {
 	*x = DeleteChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[14]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -838,7 +2067,7 @@ func (x *DeleteChannelRequest) String() string {
 func (*DeleteChannelRequest) ProtoMessage() {}
 
 func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[14]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +2080,7 @@ func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
 func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{14}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteChannelRequest) GetId() string {
@@ -875,7 +2104,7 @@ type Channel struct {
 
 func (x *Channel) Reset() {
 	*x = Channel{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[15]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -887,7 +2116,7 @@ func (x *Channel) String() string {
 func (*Channel) ProtoMessage() {}
 
 func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[15]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +2129,7 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Channel.ProtoReflect.Descriptor instead.
 func (*Channel) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{15}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Channel) GetId() string {
@@ -962,7 +2191,7 @@ type TelegramChat struct {
 
 func (x *TelegramChat) Reset() {
 	*x = TelegramChat{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[16]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -974,7 +2203,7 @@ func (x *TelegramChat) String() string {
 func (*TelegramChat) ProtoMessage() {}
 
 func (x *TelegramChat) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[16]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +2216,7 @@ func (x *TelegramChat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelegramChat.ProtoReflect.Descriptor instead.
 func (*TelegramChat) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{16}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TelegramChat) GetId() int64 {
@@ -1021,7 +2250,7 @@ type MatrixChannel struct {
 
 func (x *MatrixChannel) Reset() {
 	*x = MatrixChannel{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[17]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +2262,7 @@ func (x *MatrixChannel) String() string {
 func (*MatrixChannel) ProtoMessage() {}
 
 func (x *MatrixChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[17]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +2275,7 @@ func (x *MatrixChannel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatrixChannel.ProtoReflect.Descriptor instead.
 func (*MatrixChannel) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{17}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *MatrixChannel) GetRoomId() string {
@@ -1063,6 +2292,118 @@ func (x *MatrixChannel) GetName() string {
 	return ""
 }
 
+type ListChannelCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelCalendarsRequest) Reset() {
+	*x = ListChannelCalendarsRequest{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelCalendarsRequest) ProtoMessage() {}
+
+func (x *ListChannelCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListChannelCalendarsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ListChannelCalendarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChannelCalendarsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChannelCalendarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendars     []*Calendar            `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelCalendarsResponse) Reset() {
+	*x = ListChannelCalendarsResponse{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelCalendarsResponse) ProtoMessage() {}
+
+func (x *ListChannelCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListChannelCalendarsResponse) GetCalendars() []*Calendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+func (x *ListChannelCalendarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ListCalendarChannelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CalendarId    string                 `protobuf:"bytes,1,opt,name=calendar_id,proto3" json:"calendar_id,omitempty"`
@@ -1074,7 +2415,7 @@ type ListCalendarChannelsRequest struct {
 
 func (x *ListCalendarChannelsRequest) Reset() {
 	*x = ListCalendarChannelsRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[18]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +2427,7 @@ func (x *ListCalendarChannelsRequest) String() string {
 func (*ListCalendarChannelsRequest) ProtoMessage() {}
 
 func (x *ListCalendarChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[18]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +2440,7 @@ func (x *ListCalendarChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCalendarChannelsRequest.ProtoReflect.Descriptor instead.
 func (*ListCalendarChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{18}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ListCalendarChannelsRequest) GetCalendarId() string {
@@ -1133,7 +2474,7 @@ type ListCalendarChannelsResponse struct {
 
 func (x *ListCalendarChannelsResponse) Reset() {
 	*x = ListCalendarChannelsResponse{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[19]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +2486,7 @@ func (x *ListCalendarChannelsResponse) String() string {
 func (*ListCalendarChannelsResponse) ProtoMessage() {}
 
 func (x *ListCalendarChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[19]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +2499,7 @@ func (x *ListCalendarChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCalendarChannelsResponse.ProtoReflect.Descriptor instead.
 func (*ListCalendarChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{19}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ListCalendarChannelsResponse) GetChannels() []*Channel {
@@ -1185,7 +2526,7 @@ type CreateCalendarChannelRequest struct {
 
 func (x *CreateCalendarChannelRequest) Reset() {
 	*x = CreateCalendarChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[20]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +2538,7 @@ func (x *CreateCalendarChannelRequest) String() string {
 func (*CreateCalendarChannelRequest) ProtoMessage() {}
 
 func (x *CreateCalendarChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[20]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +2551,7 @@ func (x *CreateCalendarChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCalendarChannelRequest.ProtoReflect.Descriptor instead.
 func (*CreateCalendarChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{20}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateCalendarChannelRequest) GetCalendarId() string {
@@ -1237,7 +2578,7 @@ type DeleteCalendarChannelRequest struct {
 
 func (x *DeleteCalendarChannelRequest) Reset() {
 	*x = DeleteCalendarChannelRequest{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[21]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1249,7 +2590,7 @@ func (x *DeleteCalendarChannelRequest) String() string {
 func (*DeleteCalendarChannelRequest) ProtoMessage() {}
 
 func (x *DeleteCalendarChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[21]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +2603,7 @@ func (x *DeleteCalendarChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCalendarChannelRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCalendarChannelRequest) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{21}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteCalendarChannelRequest) GetCalendarId() string {
@@ -1288,7 +2629,7 @@ type PageToken struct {
 
 func (x *PageToken) Reset() {
 	*x = PageToken{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[22]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1300,7 +2641,7 @@ func (x *PageToken) String() string {
 func (*PageToken) ProtoMessage() {}
 
 func (x *PageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[22]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,7 +2654,7 @@ func (x *PageToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageToken.ProtoReflect.Descriptor instead.
 func (*PageToken) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{22}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PageToken) GetLastId() string {
@@ -1334,7 +2675,7 @@ type EventNotification struct {
 
 func (x *EventNotification) Reset() {
 	*x = EventNotification{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[23]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1346,7 +2687,7 @@ func (x *EventNotification) String() string {
 func (*EventNotification) ProtoMessage() {}
 
 func (x *EventNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[23]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +2700,7 @@ func (x *EventNotification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventNotification.ProtoReflect.Descriptor instead.
 func (*EventNotification) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{23}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *EventNotification) GetId() string {
@@ -1397,7 +2738,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[24]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +2750,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[24]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +2763,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{24}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *Event) GetId() string {
@@ -1468,15 +2809,18 @@ func (x *Event) GetDuration() *durationpb.Duration {
 }
 
 type EventNotificationAcknowledge struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Channels the notification could not be delivered to. It is sent again for these channels, until the maximum
+	// number of attempts is reached. All other channels of the notification are marked as delivered.
+	Failures      []*DeliveryFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventNotificationAcknowledge) Reset() {
 	*x = EventNotificationAcknowledge{}
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[25]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1488,7 +2832,7 @@ func (x *EventNotificationAcknowledge) String() string {
 func (*EventNotificationAcknowledge) ProtoMessage() {}
 
 func (x *EventNotificationAcknowledge) ProtoReflect() protoreflect.Message {
-	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[25]
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +2845,7 @@ func (x *EventNotificationAcknowledge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventNotificationAcknowledge.ProtoReflect.Descriptor instead.
 func (*EventNotificationAcknowledge) Descriptor() ([]byte, []int) {
-	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{25}
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *EventNotificationAcknowledge) GetId() string {
@@ -1511,6 +2855,117 @@ func (x *EventNotificationAcknowledge) GetId() string {
 	return ""
 }
 
+func (x *EventNotificationAcknowledge) GetFailures() []*DeliveryFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type DeliveryFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryFailure) Reset() {
+	*x = DeliveryFailure{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryFailure) ProtoMessage() {}
+
+func (x *DeliveryFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryFailure.ProtoReflect.Descriptor instead.
+func (*DeliveryFailure) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeliveryFailure) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *DeliveryFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FeedCredentials_BasicAuth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedCredentials_BasicAuth) Reset() {
+	*x = FeedCredentials_BasicAuth{}
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedCredentials_BasicAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedCredentials_BasicAuth) ProtoMessage() {}
+
+func (x *FeedCredentials_BasicAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedCredentials_BasicAuth.ProtoReflect.Descriptor instead.
+func (*FeedCredentials_BasicAuth) Descriptor() ([]byte, []int) {
+	return file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *FeedCredentials_BasicAuth) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FeedCredentials_BasicAuth) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 var File_ical_bot_backend_v1_ical_bot_backend_proto protoreflect.FileDescriptor
 
 var file_ical_bot_backend_v1_ical_bot_backend_proto_rawDesc = string([]byte{
@@ -1553,323 +3008,610 @@ var file_ical_bot_backend_v1_ical_bot_backend_proto_rawDesc = string([]byte{
 	0x32, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
 	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
 	0x64, 0x61, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x7e, 0x0a, 0x15, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62,
-	0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x15,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
+	0x65, 0x72, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x50, 0x0a, 0x15,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x7e,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22,
+	0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xb4, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x6c, 0x61, 0x72,
+	0x6d, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xe6, 0x03, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x16, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62,
 	0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
 	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x12, 0x3a, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x27, 0x0a, 0x15,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xaa, 0x03, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75,
-	0x72, 0x6c, 0x12, 0x42, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x61, 0x6c, 0x61, 0x72,
+	0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a,
+	0x17, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
+	0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x91, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x5f, 0x64,
+	0x61, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x43, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x6c, 0x61, 0x72, 0x6d, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x41, 0x6c, 0x61, 0x72, 0x6d, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5e, 0x0a, 0x15, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x6d,
-	0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x54, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d,
-	0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x78, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x6c, 0x61, 0x72, 0x6d, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51,
+	0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x9e, 0x09, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x42,
+	0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65,
+	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x6d,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5e, 0x0a, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3e,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x65, 0x74, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x65, 0x74, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x12,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x51, 0x0a, 0x11, 0x61, 0x6c,
+	0x6c, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x44,
+	0x61, 0x79, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x5f,
+	0x64, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x68, 0x61, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
+	0x35, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x64, 0x61, 0x76,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
+	0x44, 0x41, 0x56, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6c,
+	0x64, 0x61, 0x76, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x69, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x63, 0x61,
 	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4e, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa8,
-	0x01, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
-	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x48,
-	0x00, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x06, 0x6d,
-	0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x63,
+	0x2e, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x06, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x22, 0x1e, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x22, 0x6a, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x44, 0x41, 0x56, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x74,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x74, 0x61, 0x67, 0x22, 0xe8,
+	0x02, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x50, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x2e, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x63,
 	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48,
-	0x00, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x7d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x82, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x31, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x43, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0f, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22,
+	0x7f, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x44, 0x61, 0x79, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x79,
+	0x22, 0x20, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
+	0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
 	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22,
-	0x8f, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa8, 0x01, 0x0a,
+	0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x48, 0x00, 0x52,
+	0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x06, 0x6d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x63, 0x61, 0x6c,
 	0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x22, 0xe6, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x1c, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0xa0, 0x01, 0x0a, 0x13, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f,
-	0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45,
-	0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52,
-	0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x46, 0x41,
-	0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x46, 0x41, 0x55,
-	0x4c, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x04, 0x32, 0x85, 0x0f,
-	0x0a, 0x0e, 0x49, 0x63, 0x61, 0x6c, 0x42, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12,
-	0x27, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x28, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x00, 0x52,
+	0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x3d, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7b,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x09,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x82, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x25, 0x0a, 0x09, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62,
+	0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a,
+	0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x47, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0xa0, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x49,
+	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52,
+	0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x50,
+	0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x41, 0x44, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x45, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x04, 0x32, 0xc4, 0x15, 0x0a, 0x0e,
+	0x49, 0x63, 0x61, 0x6c, 0x42, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x27, 0x2e,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x28, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x8b, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x73, 0x12, 0x29, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0x80, 0x01,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x23, 0xba, 0x47, 0x0b,
+	0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73,
+	0x12, 0x8e, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x31,
+	0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x32, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x69, 0x64,
+	0x7d, 0x12, 0x7e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f,
 	0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0xba, 0x47, 0x0b, 0x0a,
-	0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
-	0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12,
-	0x80, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x23, 0xba,
-	0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0f, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
-	0x72, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
-	0x22, 0x31, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x32, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65,
-	0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e,
-	0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2a, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
-	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0xba, 0x47, 0x0b, 0x0a, 0x09,
-	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a,
-	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x12, 0x26, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
-	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69,
+	0x7d, 0x12, 0x95, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x12, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69,
 	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
-	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x6e, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29,
-	0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22,
-	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x7b, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
+	0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x73, 0x79, 0x6e, 0x63, 0x12, 0xb3, 0x01, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x2f, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x9c, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x2b, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x3a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0xa4,
+	0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x22, 0x3f, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x6d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
 	0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c,
-	0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x32,
-	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x2e, 0x69, 0x63,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x6e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x29, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63,
 	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x19,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb7, 0x01, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c,
-	0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0e, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x7b, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x29, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x32, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f,
+	0x7b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x29, 0x2e,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
 	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x31, 0x2e,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f,
+	0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f,
+	0x7b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12, 0xb7, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x30, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x12, 0xa4, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x31, 0x2e, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
 	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x3a,
-	0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x26, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x47, 0xba, 0x47, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x2a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x3a, 0xba, 0x47, 0x0b,
+	0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x47, 0xba, 0x47,
+	0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x33, 0x2a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x31, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x1a, 0x26, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x5f,
 	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x1a, 0x26, 0x2e, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62,
-	0x6f, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0xa6, 0x02, 0xba, 0x47, 0xde, 0x01, 0x12, 0x4f, 0x0a, 0x14,
-	0x69, 0x63, 0x61, 0x6c, 0x2d, 0x62, 0x6f, 0x74, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x2d, 0x61, 0x70, 0x69, 0x12, 0x32, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x69, 0x43,
-	0x61, 0x6c, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x1a, 0x24, 0x0a,
-	0x15, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73,
-	0x74, 0x3a, 0x38, 0x30, 0x38, 0x30, 0x12, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2a, 0x20, 0x3a, 0x1e, 0x0a, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x0f, 0x0a, 0x0d, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x2a, 0x05,
-	0x62, 0x61, 0x73, 0x69, 0x63, 0x3a, 0x23, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
-	0x72, 0x73, 0x12, 0x16, 0x69, 0x43, 0x61, 0x6c, 0x20, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
-	0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x1e, 0x0a, 0x08, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x32, 0x34,
-	0x36, 0x2f, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x69, 0x63, 0x61, 0x6c, 0x2d,
-	0x62, 0x6f, 0x74, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x58, 0x00,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x42, 0xa6, 0x02, 0xba, 0x47, 0xde, 0x01, 0x12, 0x4f, 0x0a, 0x14, 0x69, 0x63, 0x61,
+	0x6c, 0x2d, 0x62, 0x6f, 0x74, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2d, 0x61, 0x70,
+	0x69, 0x12, 0x32, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x69, 0x43, 0x61, 0x6c, 0x20,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x1a, 0x24, 0x0a, 0x15, 0x68, 0x74,
+	0x74, 0x70, 0x3a, 0x2f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38,
+	0x30, 0x38, 0x30, 0x12, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2a, 0x20, 0x3a, 0x1e, 0x0a, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x0f, 0x0a, 0x0d, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x2a, 0x05, 0x62, 0x61, 0x73,
+	0x69, 0x63, 0x3a, 0x23, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x12,
+	0x16, 0x69, 0x43, 0x61, 0x6c, 0x20, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x1e, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x20, 0x74, 0x6f,
+	0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x74, 0x72, 0x69, 0x63, 0x6b, 0x32, 0x34, 0x36, 0x2f, 0x69,
+	0x63, 0x61, 0x6c, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x62, 0x6f, 0x74,
+	0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x58, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1885,7 +3627,7 @@ func file_ical_bot_backend_v1_ical_bot_backend_proto_rawDescGZIP() []byte {
 }
 
 var file_ical_bot_backend_v1_ical_bot_backend_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_ical_bot_backend_v1_ical_bot_backend_proto_goTypes = []any{
 	(DefaultReminderMode)(0),             // 0: ical_bot_backend.v1.DefaultReminderMode
 	(*CreateCalendarRequest)(nil),        // 1: ical_bot_backend.v1.CreateCalendarRequest
@@ -1895,87 +3637,144 @@ var file_ical_bot_backend_v1_ical_bot_backend_proto_goTypes = []any{
 	(*ListCalendarsResponse)(nil),        // 5: ical_bot_backend.v1.ListCalendarsResponse
 	(*UpdateCalendarRequest)(nil),        // 6: ical_bot_backend.v1.UpdateCalendarRequest
 	(*DeleteCalendarRequest)(nil),        // 7: ical_bot_backend.v1.DeleteCalendarRequest
-	(*Calendar)(nil),                     // 8: ical_bot_backend.v1.Calendar
-	(*DefaultReminder)(nil),              // 9: ical_bot_backend.v1.DefaultReminder
-	(*GetChannelRequest)(nil),            // 10: ical_bot_backend.v1.GetChannelRequest
-	(*ListChannelsRequest)(nil),          // 11: ical_bot_backend.v1.ListChannelsRequest
-	(*ListChannelsResponse)(nil),         // 12: ical_bot_backend.v1.ListChannelsResponse
-	(*CreateChannelRequest)(nil),         // 13: ical_bot_backend.v1.CreateChannelRequest
-	(*UpdateChannelRequest)(nil),         // 14: ical_bot_backend.v1.UpdateChannelRequest
-	(*DeleteChannelRequest)(nil),         // 15: ical_bot_backend.v1.DeleteChannelRequest
-	(*Channel)(nil),                      // 16: ical_bot_backend.v1.Channel
-	(*TelegramChat)(nil),                 // 17: ical_bot_backend.v1.TelegramChat
-	(*MatrixChannel)(nil),                // 18: ical_bot_backend.v1.MatrixChannel
-	(*ListCalendarChannelsRequest)(nil),  // 19: ical_bot_backend.v1.ListCalendarChannelsRequest
-	(*ListCalendarChannelsResponse)(nil), // 20: ical_bot_backend.v1.ListCalendarChannelsResponse
-	(*CreateCalendarChannelRequest)(nil), // 21: ical_bot_backend.v1.CreateCalendarChannelRequest
-	(*DeleteCalendarChannelRequest)(nil), // 22: ical_bot_backend.v1.DeleteCalendarChannelRequest
-	(*PageToken)(nil),                    // 23: ical_bot_backend.v1.PageToken
-	(*EventNotification)(nil),            // 24: ical_bot_backend.v1.EventNotification
-	(*Event)(nil),                        // 25: ical_bot_backend.v1.Event
-	(*EventNotificationAcknowledge)(nil), // 26: ical_bot_backend.v1.EventNotificationAcknowledge
-	(*timestamppb.Timestamp)(nil),        // 27: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 28: google.protobuf.FieldMask
-	(*status.Status)(nil),                // 29: google.rpc.Status
-	(*durationpb.Duration)(nil),          // 30: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                // 31: google.protobuf.Empty
+	(*SyncCalendarRequest)(nil),          // 8: ical_bot_backend.v1.SyncCalendarRequest
+	(*SyncCalendarResponse)(nil),         // 9: ical_bot_backend.v1.SyncCalendarResponse
+	(*ListCalendarImportsRequest)(nil),   // 10: ical_bot_backend.v1.ListCalendarImportsRequest
+	(*ListCalendarImportsResponse)(nil),  // 11: ical_bot_backend.v1.ListCalendarImportsResponse
+	(*CalendarImport)(nil),               // 12: ical_bot_backend.v1.CalendarImport
+	(*PreviewCalendarRequest)(nil),       // 13: ical_bot_backend.v1.PreviewCalendarRequest
+	(*PreviewCalendarResponse)(nil),      // 14: ical_bot_backend.v1.PreviewCalendarResponse
+	(*PreviewEvent)(nil),                 // 15: ical_bot_backend.v1.PreviewEvent
+	(*PreviewAlarm)(nil),                 // 16: ical_bot_backend.v1.PreviewAlarm
+	(*ImportCalendarDataRequest)(nil),    // 17: ical_bot_backend.v1.ImportCalendarDataRequest
+	(*Calendar)(nil),                     // 18: ical_bot_backend.v1.Calendar
+	(*HTTPSource)(nil),                   // 19: ical_bot_backend.v1.HTTPSource
+	(*CalDAVSource)(nil),                 // 20: ical_bot_backend.v1.CalDAVSource
+	(*FeedCredentials)(nil),              // 21: ical_bot_backend.v1.FeedCredentials
+	(*DefaultReminder)(nil),              // 22: ical_bot_backend.v1.DefaultReminder
+	(*AllDayReminder)(nil),               // 23: ical_bot_backend.v1.AllDayReminder
+	(*FileSource)(nil),                   // 24: ical_bot_backend.v1.FileSource
+	(*InlineSource)(nil),                 // 25: ical_bot_backend.v1.InlineSource
+	(*GetChannelRequest)(nil),            // 26: ical_bot_backend.v1.GetChannelRequest
+	(*ListChannelsRequest)(nil),          // 27: ical_bot_backend.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),         // 28: ical_bot_backend.v1.ListChannelsResponse
+	(*CreateChannelRequest)(nil),         // 29: ical_bot_backend.v1.CreateChannelRequest
+	(*UpdateChannelRequest)(nil),         // 30: ical_bot_backend.v1.UpdateChannelRequest
+	(*DeleteChannelRequest)(nil),         // 31: ical_bot_backend.v1.DeleteChannelRequest
+	(*Channel)(nil),                      // 32: ical_bot_backend.v1.Channel
+	(*TelegramChat)(nil),                 // 33: ical_bot_backend.v1.TelegramChat
+	(*MatrixChannel)(nil),                // 34: ical_bot_backend.v1.MatrixChannel
+	(*ListChannelCalendarsRequest)(nil),  // 35: ical_bot_backend.v1.ListChannelCalendarsRequest
+	(*ListChannelCalendarsResponse)(nil), // 36: ical_bot_backend.v1.ListChannelCalendarsResponse
+	(*ListCalendarChannelsRequest)(nil),  // 37: ical_bot_backend.v1.ListCalendarChannelsRequest
+	(*ListCalendarChannelsResponse)(nil), // 38: ical_bot_backend.v1.ListCalendarChannelsResponse
+	(*CreateCalendarChannelRequest)(nil), // 39: ical_bot_backend.v1.CreateCalendarChannelRequest
+	(*DeleteCalendarChannelRequest)(nil), // 40: ical_bot_backend.v1.DeleteCalendarChannelRequest
+	(*PageToken)(nil),                    // 41: ical_bot_backend.v1.PageToken
+	(*EventNotification)(nil),            // 42: ical_bot_backend.v1.EventNotification
+	(*Event)(nil),                        // 43: ical_bot_backend.v1.Event
+	(*EventNotificationAcknowledge)(nil), // 44: ical_bot_backend.v1.EventNotificationAcknowledge
+	(*DeliveryFailure)(nil),              // 45: ical_bot_backend.v1.DeliveryFailure
+	(*FeedCredentials_BasicAuth)(nil),    // 46: ical_bot_backend.v1.FeedCredentials.BasicAuth
+	nil,                                  // 47: ical_bot_backend.v1.FeedCredentials.HeadersEntry
+	(*timestamppb.Timestamp)(nil),        // 48: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 49: google.protobuf.FieldMask
+	(*status.Status)(nil),                // 50: google.rpc.Status
+	(*durationpb.Duration)(nil),          // 51: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                // 52: google.protobuf.Empty
 }
 var file_ical_bot_backend_v1_ical_bot_backend_proto_depIdxs = []int32{
-	8,  // 0: ical_bot_backend.v1.CreateCalendarRequest.calendar:type_name -> ical_bot_backend.v1.Calendar
+	18, // 0: ical_bot_backend.v1.CreateCalendarRequest.calendar:type_name -> ical_bot_backend.v1.Calendar
 	4,  // 1: ical_bot_backend.v1.ListCalendarsRequest.filter:type_name -> ical_bot_backend.v1.ListCalendarsFilter
-	27, // 2: ical_bot_backend.v1.ListCalendarsFilter.last_sync_time_before:type_name -> google.protobuf.Timestamp
-	8,  // 3: ical_bot_backend.v1.ListCalendarsResponse.calendars:type_name -> ical_bot_backend.v1.Calendar
-	8,  // 4: ical_bot_backend.v1.UpdateCalendarRequest.calendar:type_name -> ical_bot_backend.v1.Calendar
-	28, // 5: ical_bot_backend.v1.UpdateCalendarRequest.field_mask:type_name -> google.protobuf.FieldMask
-	27, // 6: ical_bot_backend.v1.Calendar.last_sync_time:type_name -> google.protobuf.Timestamp
-	9,  // 7: ical_bot_backend.v1.Calendar.default_reminders:type_name -> ical_bot_backend.v1.DefaultReminder
-	0,  // 8: ical_bot_backend.v1.Calendar.default_reminder_mode:type_name -> ical_bot_backend.v1.DefaultReminderMode
-	29, // 9: ical_bot_backend.v1.Calendar.last_sync_error:type_name -> google.rpc.Status
-	30, // 10: ical_bot_backend.v1.DefaultReminder.before:type_name -> google.protobuf.Duration
-	16, // 11: ical_bot_backend.v1.ListChannelsResponse.channels:type_name -> ical_bot_backend.v1.Channel
-	16, // 12: ical_bot_backend.v1.CreateChannelRequest.channel:type_name -> ical_bot_backend.v1.Channel
-	16, // 13: ical_bot_backend.v1.UpdateChannelRequest.channel:type_name -> ical_bot_backend.v1.Channel
-	28, // 14: ical_bot_backend.v1.UpdateChannelRequest.field_mask:type_name -> google.protobuf.FieldMask
-	17, // 15: ical_bot_backend.v1.Channel.telegram:type_name -> ical_bot_backend.v1.TelegramChat
-	18, // 16: ical_bot_backend.v1.Channel.matrix:type_name -> ical_bot_backend.v1.MatrixChannel
-	16, // 17: ical_bot_backend.v1.ListCalendarChannelsResponse.channels:type_name -> ical_bot_backend.v1.Channel
-	25, // 18: ical_bot_backend.v1.EventNotification.event:type_name -> ical_bot_backend.v1.Event
-	16, // 19: ical_bot_backend.v1.EventNotification.channels:type_name -> ical_bot_backend.v1.Channel
-	27, // 20: ical_bot_backend.v1.Event.start_time:type_name -> google.protobuf.Timestamp
-	30, // 21: ical_bot_backend.v1.Event.duration:type_name -> google.protobuf.Duration
-	2,  // 22: ical_bot_backend.v1.IcalBotService.GetCalendar:input_type -> ical_bot_backend.v1.GetCalendarRequest
-	3,  // 23: ical_bot_backend.v1.IcalBotService.ListCalendars:input_type -> ical_bot_backend.v1.ListCalendarsRequest
-	1,  // 24: ical_bot_backend.v1.IcalBotService.CreateCalendar:input_type -> ical_bot_backend.v1.CreateCalendarRequest
-	6,  // 25: ical_bot_backend.v1.IcalBotService.UpdateCalendar:input_type -> ical_bot_backend.v1.UpdateCalendarRequest
-	7,  // 26: ical_bot_backend.v1.IcalBotService.DeleteCalendar:input_type -> ical_bot_backend.v1.DeleteCalendarRequest
-	10, // 27: ical_bot_backend.v1.IcalBotService.GetChannel:input_type -> ical_bot_backend.v1.GetChannelRequest
-	11, // 28: ical_bot_backend.v1.IcalBotService.ListChannels:input_type -> ical_bot_backend.v1.ListChannelsRequest
-	13, // 29: ical_bot_backend.v1.IcalBotService.CreateChannel:input_type -> ical_bot_backend.v1.CreateChannelRequest
-	14, // 30: ical_bot_backend.v1.IcalBotService.UpdateChannel:input_type -> ical_bot_backend.v1.UpdateChannelRequest
-	15, // 31: ical_bot_backend.v1.IcalBotService.DeleteChannel:input_type -> ical_bot_backend.v1.DeleteChannelRequest
-	19, // 32: ical_bot_backend.v1.IcalBotService.ListCalendarChannels:input_type -> ical_bot_backend.v1.ListCalendarChannelsRequest
-	21, // 33: ical_bot_backend.v1.IcalBotService.CreateCalendarChannel:input_type -> ical_bot_backend.v1.CreateCalendarChannelRequest
-	22, // 34: ical_bot_backend.v1.IcalBotService.DeleteCalendarChannel:input_type -> ical_bot_backend.v1.DeleteCalendarChannelRequest
-	26, // 35: ical_bot_backend.v1.IcalBotService.StreamEventNotifications:input_type -> ical_bot_backend.v1.EventNotificationAcknowledge
-	8,  // 36: ical_bot_backend.v1.IcalBotService.GetCalendar:output_type -> ical_bot_backend.v1.Calendar
-	5,  // 37: ical_bot_backend.v1.IcalBotService.ListCalendars:output_type -> ical_bot_backend.v1.ListCalendarsResponse
-	8,  // 38: ical_bot_backend.v1.IcalBotService.CreateCalendar:output_type -> ical_bot_backend.v1.Calendar
-	8,  // 39: ical_bot_backend.v1.IcalBotService.UpdateCalendar:output_type -> ical_bot_backend.v1.Calendar
-	31, // 40: ical_bot_backend.v1.IcalBotService.DeleteCalendar:output_type -> google.protobuf.Empty
-	16, // 41: ical_bot_backend.v1.IcalBotService.GetChannel:output_type -> ical_bot_backend.v1.Channel
-	12, // 42: ical_bot_backend.v1.IcalBotService.ListChannels:output_type -> ical_bot_backend.v1.ListChannelsResponse
-	16, // 43: ical_bot_backend.v1.IcalBotService.CreateChannel:output_type -> ical_bot_backend.v1.Channel
-	16, // 44: ical_bot_backend.v1.IcalBotService.UpdateChannel:output_type -> ical_bot_backend.v1.Channel
-	31, // 45: ical_bot_backend.v1.IcalBotService.DeleteChannel:output_type -> google.protobuf.Empty
-	20, // 46: ical_bot_backend.v1.IcalBotService.ListCalendarChannels:output_type -> ical_bot_backend.v1.ListCalendarChannelsResponse
-	16, // 47: ical_bot_backend.v1.IcalBotService.CreateCalendarChannel:output_type -> ical_bot_backend.v1.Channel
-	31, // 48: ical_bot_backend.v1.IcalBotService.DeleteCalendarChannel:output_type -> google.protobuf.Empty
-	24, // 49: ical_bot_backend.v1.IcalBotService.StreamEventNotifications:output_type -> ical_bot_backend.v1.EventNotification
-	36, // [36:50] is the sub-list for method output_type
-	22, // [22:36] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	48, // 2: ical_bot_backend.v1.ListCalendarsFilter.last_sync_time_before:type_name -> google.protobuf.Timestamp
+	48, // 3: ical_bot_backend.v1.ListCalendarsFilter.next_sync_time_before:type_name -> google.protobuf.Timestamp
+	18, // 4: ical_bot_backend.v1.ListCalendarsResponse.calendars:type_name -> ical_bot_backend.v1.Calendar
+	18, // 5: ical_bot_backend.v1.UpdateCalendarRequest.calendar:type_name -> ical_bot_backend.v1.Calendar
+	49, // 6: ical_bot_backend.v1.UpdateCalendarRequest.field_mask:type_name -> google.protobuf.FieldMask
+	50, // 7: ical_bot_backend.v1.SyncCalendarResponse.error:type_name -> google.rpc.Status
+	12, // 8: ical_bot_backend.v1.ListCalendarImportsResponse.imports:type_name -> ical_bot_backend.v1.CalendarImport
+	48, // 9: ical_bot_backend.v1.CalendarImport.start_time:type_name -> google.protobuf.Timestamp
+	48, // 10: ical_bot_backend.v1.CalendarImport.end_time:type_name -> google.protobuf.Timestamp
+	50, // 11: ical_bot_backend.v1.CalendarImport.error:type_name -> google.rpc.Status
+	18, // 12: ical_bot_backend.v1.PreviewCalendarRequest.calendar:type_name -> ical_bot_backend.v1.Calendar
+	15, // 13: ical_bot_backend.v1.PreviewCalendarResponse.events:type_name -> ical_bot_backend.v1.PreviewEvent
+	48, // 14: ical_bot_backend.v1.PreviewEvent.recurrence_id:type_name -> google.protobuf.Timestamp
+	43, // 15: ical_bot_backend.v1.PreviewEvent.event:type_name -> ical_bot_backend.v1.Event
+	16, // 16: ical_bot_backend.v1.PreviewEvent.next_alarms:type_name -> ical_bot_backend.v1.PreviewAlarm
+	48, // 17: ical_bot_backend.v1.PreviewAlarm.alarm_time:type_name -> google.protobuf.Timestamp
+	48, // 18: ical_bot_backend.v1.PreviewAlarm.event_time:type_name -> google.protobuf.Timestamp
+	48, // 19: ical_bot_backend.v1.Calendar.last_sync_time:type_name -> google.protobuf.Timestamp
+	22, // 20: ical_bot_backend.v1.Calendar.default_reminders:type_name -> ical_bot_backend.v1.DefaultReminder
+	0,  // 21: ical_bot_backend.v1.Calendar.default_reminder_mode:type_name -> ical_bot_backend.v1.DefaultReminderMode
+	50, // 22: ical_bot_backend.v1.Calendar.last_sync_error:type_name -> google.rpc.Status
+	23, // 23: ical_bot_backend.v1.Calendar.all_day_reminders:type_name -> ical_bot_backend.v1.AllDayReminder
+	21, // 24: ical_bot_backend.v1.Calendar.credentials:type_name -> ical_bot_backend.v1.FeedCredentials
+	19, // 25: ical_bot_backend.v1.Calendar.http:type_name -> ical_bot_backend.v1.HTTPSource
+	20, // 26: ical_bot_backend.v1.Calendar.caldav:type_name -> ical_bot_backend.v1.CalDAVSource
+	24, // 27: ical_bot_backend.v1.Calendar.file:type_name -> ical_bot_backend.v1.FileSource
+	25, // 28: ical_bot_backend.v1.Calendar.inline:type_name -> ical_bot_backend.v1.InlineSource
+	51, // 29: ical_bot_backend.v1.Calendar.sync_interval:type_name -> google.protobuf.Duration
+	48, // 30: ical_bot_backend.v1.Calendar.next_sync_time:type_name -> google.protobuf.Timestamp
+	46, // 31: ical_bot_backend.v1.FeedCredentials.basic_auth:type_name -> ical_bot_backend.v1.FeedCredentials.BasicAuth
+	47, // 32: ical_bot_backend.v1.FeedCredentials.headers:type_name -> ical_bot_backend.v1.FeedCredentials.HeadersEntry
+	51, // 33: ical_bot_backend.v1.DefaultReminder.before:type_name -> google.protobuf.Duration
+	51, // 34: ical_bot_backend.v1.AllDayReminder.time_of_day:type_name -> google.protobuf.Duration
+	32, // 35: ical_bot_backend.v1.ListChannelsResponse.channels:type_name -> ical_bot_backend.v1.Channel
+	32, // 36: ical_bot_backend.v1.CreateChannelRequest.channel:type_name -> ical_bot_backend.v1.Channel
+	32, // 37: ical_bot_backend.v1.UpdateChannelRequest.channel:type_name -> ical_bot_backend.v1.Channel
+	49, // 38: ical_bot_backend.v1.UpdateChannelRequest.field_mask:type_name -> google.protobuf.FieldMask
+	33, // 39: ical_bot_backend.v1.Channel.telegram:type_name -> ical_bot_backend.v1.TelegramChat
+	34, // 40: ical_bot_backend.v1.Channel.matrix:type_name -> ical_bot_backend.v1.MatrixChannel
+	18, // 41: ical_bot_backend.v1.ListChannelCalendarsResponse.calendars:type_name -> ical_bot_backend.v1.Calendar
+	32, // 42: ical_bot_backend.v1.ListCalendarChannelsResponse.channels:type_name -> ical_bot_backend.v1.Channel
+	43, // 43: ical_bot_backend.v1.EventNotification.event:type_name -> ical_bot_backend.v1.Event
+	32, // 44: ical_bot_backend.v1.EventNotification.channels:type_name -> ical_bot_backend.v1.Channel
+	48, // 45: ical_bot_backend.v1.Event.start_time:type_name -> google.protobuf.Timestamp
+	51, // 46: ical_bot_backend.v1.Event.duration:type_name -> google.protobuf.Duration
+	45, // 47: ical_bot_backend.v1.EventNotificationAcknowledge.failures:type_name -> ical_bot_backend.v1.DeliveryFailure
+	2,  // 48: ical_bot_backend.v1.IcalBotService.GetCalendar:input_type -> ical_bot_backend.v1.GetCalendarRequest
+	3,  // 49: ical_bot_backend.v1.IcalBotService.ListCalendars:input_type -> ical_bot_backend.v1.ListCalendarsRequest
+	1,  // 50: ical_bot_backend.v1.IcalBotService.CreateCalendar:input_type -> ical_bot_backend.v1.CreateCalendarRequest
+	6,  // 51: ical_bot_backend.v1.IcalBotService.UpdateCalendar:input_type -> ical_bot_backend.v1.UpdateCalendarRequest
+	7,  // 52: ical_bot_backend.v1.IcalBotService.DeleteCalendar:input_type -> ical_bot_backend.v1.DeleteCalendarRequest
+	8,  // 53: ical_bot_backend.v1.IcalBotService.SyncCalendar:input_type -> ical_bot_backend.v1.SyncCalendarRequest
+	10, // 54: ical_bot_backend.v1.IcalBotService.ListCalendarImports:input_type -> ical_bot_backend.v1.ListCalendarImportsRequest
+	13, // 55: ical_bot_backend.v1.IcalBotService.PreviewCalendar:input_type -> ical_bot_backend.v1.PreviewCalendarRequest
+	17, // 56: ical_bot_backend.v1.IcalBotService.ImportCalendarData:input_type -> ical_bot_backend.v1.ImportCalendarDataRequest
+	26, // 57: ical_bot_backend.v1.IcalBotService.GetChannel:input_type -> ical_bot_backend.v1.GetChannelRequest
+	27, // 58: ical_bot_backend.v1.IcalBotService.ListChannels:input_type -> ical_bot_backend.v1.ListChannelsRequest
+	29, // 59: ical_bot_backend.v1.IcalBotService.CreateChannel:input_type -> ical_bot_backend.v1.CreateChannelRequest
+	30, // 60: ical_bot_backend.v1.IcalBotService.UpdateChannel:input_type -> ical_bot_backend.v1.UpdateChannelRequest
+	31, // 61: ical_bot_backend.v1.IcalBotService.DeleteChannel:input_type -> ical_bot_backend.v1.DeleteChannelRequest
+	35, // 62: ical_bot_backend.v1.IcalBotService.ListChannelCalendars:input_type -> ical_bot_backend.v1.ListChannelCalendarsRequest
+	37, // 63: ical_bot_backend.v1.IcalBotService.ListCalendarChannels:input_type -> ical_bot_backend.v1.ListCalendarChannelsRequest
+	39, // 64: ical_bot_backend.v1.IcalBotService.CreateCalendarChannel:input_type -> ical_bot_backend.v1.CreateCalendarChannelRequest
+	40, // 65: ical_bot_backend.v1.IcalBotService.DeleteCalendarChannel:input_type -> ical_bot_backend.v1.DeleteCalendarChannelRequest
+	44, // 66: ical_bot_backend.v1.IcalBotService.StreamEventNotifications:input_type -> ical_bot_backend.v1.EventNotificationAcknowledge
+	18, // 67: ical_bot_backend.v1.IcalBotService.GetCalendar:output_type -> ical_bot_backend.v1.Calendar
+	5,  // 68: ical_bot_backend.v1.IcalBotService.ListCalendars:output_type -> ical_bot_backend.v1.ListCalendarsResponse
+	18, // 69: ical_bot_backend.v1.IcalBotService.CreateCalendar:output_type -> ical_bot_backend.v1.Calendar
+	18, // 70: ical_bot_backend.v1.IcalBotService.UpdateCalendar:output_type -> ical_bot_backend.v1.Calendar
+	52, // 71: ical_bot_backend.v1.IcalBotService.DeleteCalendar:output_type -> google.protobuf.Empty
+	9,  // 72: ical_bot_backend.v1.IcalBotService.SyncCalendar:output_type -> ical_bot_backend.v1.SyncCalendarResponse
+	11, // 73: ical_bot_backend.v1.IcalBotService.ListCalendarImports:output_type -> ical_bot_backend.v1.ListCalendarImportsResponse
+	14, // 74: ical_bot_backend.v1.IcalBotService.PreviewCalendar:output_type -> ical_bot_backend.v1.PreviewCalendarResponse
+	18, // 75: ical_bot_backend.v1.IcalBotService.ImportCalendarData:output_type -> ical_bot_backend.v1.Calendar
+	32, // 76: ical_bot_backend.v1.IcalBotService.GetChannel:output_type -> ical_bot_backend.v1.Channel
+	28, // 77: ical_bot_backend.v1.IcalBotService.ListChannels:output_type -> ical_bot_backend.v1.ListChannelsResponse
+	32, // 78: ical_bot_backend.v1.IcalBotService.CreateChannel:output_type -> ical_bot_backend.v1.Channel
+	32, // 79: ical_bot_backend.v1.IcalBotService.UpdateChannel:output_type -> ical_bot_backend.v1.Channel
+	52, // 80: ical_bot_backend.v1.IcalBotService.DeleteChannel:output_type -> google.protobuf.Empty
+	36, // 81: ical_bot_backend.v1.IcalBotService.ListChannelCalendars:output_type -> ical_bot_backend.v1.ListChannelCalendarsResponse
+	38, // 82: ical_bot_backend.v1.IcalBotService.ListCalendarChannels:output_type -> ical_bot_backend.v1.ListCalendarChannelsResponse
+	32, // 83: ical_bot_backend.v1.IcalBotService.CreateCalendarChannel:output_type -> ical_bot_backend.v1.Channel
+	52, // 84: ical_bot_backend.v1.IcalBotService.DeleteCalendarChannel:output_type -> google.protobuf.Empty
+	42, // 85: ical_bot_backend.v1.IcalBotService.StreamEventNotifications:output_type -> ical_bot_backend.v1.EventNotification
+	67, // [67:86] is the sub-list for method output_type
+	48, // [48:67] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_ical_bot_backend_v1_ical_bot_backend_proto_init() }
@@ -1983,7 +3782,17 @@ func file_ical_bot_backend_v1_ical_bot_backend_proto_init() {
 	if File_ical_bot_backend_v1_ical_bot_backend_proto != nil {
 		return
 	}
-	file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[15].OneofWrappers = []any{
+	file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[17].OneofWrappers = []any{
+		(*Calendar_Http)(nil),
+		(*Calendar_Caldav)(nil),
+		(*Calendar_File)(nil),
+		(*Calendar_Inline)(nil),
+	}
+	file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[20].OneofWrappers = []any{
+		(*FeedCredentials_BasicAuth_)(nil),
+		(*FeedCredentials_BearerToken)(nil),
+	}
+	file_ical_bot_backend_v1_ical_bot_backend_proto_msgTypes[31].OneofWrappers = []any{
 		(*Channel_Telegram)(nil),
 		(*Channel_Matrix)(nil),
 	}
@@ -1993,7 +3802,7 @@ func file_ical_bot_backend_v1_ical_bot_backend_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ical_bot_backend_v1_ical_bot_backend_proto_rawDesc), len(file_ical_bot_backend_v1_ical_bot_backend_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   26,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,16 @@ const (
 	IcalBotService_CreateCalendar_FullMethodName           = "/ical_bot_backend.v1.IcalBotService/CreateCalendar"
 	IcalBotService_UpdateCalendar_FullMethodName           = "/ical_bot_backend.v1.IcalBotService/UpdateCalendar"
 	IcalBotService_DeleteCalendar_FullMethodName           = "/ical_bot_backend.v1.IcalBotService/DeleteCalendar"
+	IcalBotService_SyncCalendar_FullMethodName             = "/ical_bot_backend.v1.IcalBotService/SyncCalendar"
+	IcalBotService_ListCalendarImports_FullMethodName      = "/ical_bot_backend.v1.IcalBotService/ListCalendarImports"
+	IcalBotService_PreviewCalendar_FullMethodName          = "/ical_bot_backend.v1.IcalBotService/PreviewCalendar"
+	IcalBotService_ImportCalendarData_FullMethodName       = "/ical_bot_backend.v1.IcalBotService/ImportCalendarData"
 	IcalBotService_GetChannel_FullMethodName               = "/ical_bot_backend.v1.IcalBotService/GetChannel"
 	IcalBotService_ListChannels_FullMethodName             = "/ical_bot_backend.v1.IcalBotService/ListChannels"
 	IcalBotService_CreateChannel_FullMethodName            = "/ical_bot_backend.v1.IcalBotService/CreateChannel"
 	IcalBotService_UpdateChannel_FullMethodName            = "/ical_bot_backend.v1.IcalBotService/UpdateChannel"
 	IcalBotService_DeleteChannel_FullMethodName            = "/ical_bot_backend.v1.IcalBotService/DeleteChannel"
+	IcalBotService_ListChannelCalendars_FullMethodName     = "/ical_bot_backend.v1.IcalBotService/ListChannelCalendars"
 	IcalBotService_ListCalendarChannels_FullMethodName     = "/ical_bot_backend.v1.IcalBotService/ListCalendarChannels"
 	IcalBotService_CreateCalendarChannel_FullMethodName    = "/ical_bot_backend.v1.IcalBotService/CreateCalendarChannel"
 	IcalBotService_DeleteCalendarChannel_FullMethodName    = "/ical_bot_backend.v1.IcalBotService/DeleteCalendarChannel"
@@ -46,12 +51,26 @@ type IcalBotServiceClient interface {
 	CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*Calendar, error)
 	UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*Calendar, error)
 	DeleteCalendar(ctx context.Context, in *DeleteCalendarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Imports the calendar right away and returns the outcome. Fails with ABORTED if the calendar is being imported
+	// already.
+	SyncCalendar(ctx context.Context, in *SyncCalendarRequest, opts ...grpc.CallOption) (*SyncCalendarResponse, error)
+	// Lists the import runs of the calendar, from the newest to the oldest
+	ListCalendarImports(ctx context.Context, in *ListCalendarImportsRequest, opts ...grpc.CallOption) (*ListCalendarImportsResponse, error)
+	// Fetches and parses the feed of a calendar without storing anything, and returns its events with their next alarms.
+	// The calendar doesn't need to exist, its source, time zone, credentials and reminder settings are taken from the
+	// request. Stored credentials are never used. Uploaded data is previewed with an inline source.
+	PreviewCalendar(ctx context.Context, in *PreviewCalendarRequest, opts ...grpc.CallOption) (*PreviewCalendarResponse, error)
+	// Stores the iCal data as the inline source of the calendar and imports its events right away. The data can also be
+	// uploaded as the "file" part of a multipart/form-data request to POST /v1/calendars/{calendar_id}/data.
+	ImportCalendarData(ctx context.Context, in *ImportCalendarDataRequest, opts ...grpc.CallOption) (*Calendar, error)
 	// Channels
 	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*Channel, error)
 	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
 	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
 	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the calendars the channel is subscribed to
+	ListChannelCalendars(ctx context.Context, in *ListChannelCalendarsRequest, opts ...grpc.CallOption) (*ListChannelCalendarsResponse, error)
 	// Calendar-Channels
 	ListCalendarChannels(ctx context.Context, in *ListCalendarChannelsRequest, opts ...grpc.CallOption) (*ListCalendarChannelsResponse, error)
 	CreateCalendarChannel(ctx context.Context, in *CreateCalendarChannelRequest, opts ...grpc.CallOption) (*Channel, error)
@@ -118,6 +137,46 @@ func (c *icalBotServiceClient) DeleteCalendar(ctx context.Context, in *DeleteCal
 	return out, nil
 }
 
+func (c *icalBotServiceClient) SyncCalendar(ctx context.Context, in *SyncCalendarRequest, opts ...grpc.CallOption) (*SyncCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncCalendarResponse)
+	err := c.cc.Invoke(ctx, IcalBotService_SyncCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *icalBotServiceClient) ListCalendarImports(ctx context.Context, in *ListCalendarImportsRequest, opts ...grpc.CallOption) (*ListCalendarImportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalendarImportsResponse)
+	err := c.cc.Invoke(ctx, IcalBotService_ListCalendarImports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *icalBotServiceClient) PreviewCalendar(ctx context.Context, in *PreviewCalendarRequest, opts ...grpc.CallOption) (*PreviewCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCalendarResponse)
+	err := c.cc.Invoke(ctx, IcalBotService_PreviewCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *icalBotServiceClient) ImportCalendarData(ctx context.Context, in *ImportCalendarDataRequest, opts ...grpc.CallOption) (*Calendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Calendar)
+	err := c.cc.Invoke(ctx, IcalBotService_ImportCalendarData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *icalBotServiceClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Channel)
@@ -168,6 +227,16 @@ func (c *icalBotServiceClient) DeleteChannel(ctx context.Context, in *DeleteChan
 	return out, nil
 }
 
+func (c *icalBotServiceClient) ListChannelCalendars(ctx context.Context, in *ListChannelCalendarsRequest, opts ...grpc.CallOption) (*ListChannelCalendarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelCalendarsResponse)
+	err := c.cc.Invoke(ctx, IcalBotService_ListChannelCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *icalBotServiceClient) ListCalendarChannels(ctx context.Context, in *ListCalendarChannelsRequest, opts ...grpc.CallOption) (*ListCalendarChannelsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCalendarChannelsResponse)
@@ -221,12 +290,26 @@ type IcalBotServiceServer interface {
 	CreateCalendar(context.Context, *CreateCalendarRequest) (*Calendar, error)
 	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*Calendar, error)
 	DeleteCalendar(context.Context, *DeleteCalendarRequest) (*emptypb.Empty, error)
+	// Imports the calendar right away and returns the outcome. Fails with ABORTED if the calendar is being imported
+	// already.
+	SyncCalendar(context.Context, *SyncCalendarRequest) (*SyncCalendarResponse, error)
+	// Lists the import runs of the calendar, from the newest to the oldest
+	ListCalendarImports(context.Context, *ListCalendarImportsRequest) (*ListCalendarImportsResponse, error)
+	// Fetches and parses the feed of a calendar without storing anything, and returns its events with their next alarms.
+	// The calendar doesn't need to exist, its source, time zone, credentials and reminder settings are taken from the
+	// request. Stored credentials are never used. Uploaded data is previewed with an inline source.
+	PreviewCalendar(context.Context, *PreviewCalendarRequest) (*PreviewCalendarResponse, error)
+	// Stores the iCal data as the inline source of the calendar and imports its events right away. The data can also be
+	// uploaded as the "file" part of a multipart/form-data request to POST /v1/calendars/{calendar_id}/data.
+	ImportCalendarData(context.Context, *ImportCalendarDataRequest) (*Calendar, error)
 	// Channels
 	GetChannel(context.Context, *GetChannelRequest) (*Channel, error)
 	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
 	CreateChannel(context.Context, *CreateChannelRequest) (*Channel, error)
 	UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error)
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*emptypb.Empty, error)
+	// Lists the calendars the channel is subscribed to
+	ListChannelCalendars(context.Context, *ListChannelCalendarsRequest) (*ListChannelCalendarsResponse, error)
 	// Calendar-Channels
 	ListCalendarChannels(context.Context, *ListCalendarChannelsRequest) (*ListCalendarChannelsResponse, error)
 	CreateCalendarChannel(context.Context, *CreateCalendarChannelRequest) (*Channel, error)
@@ -258,6 +341,18 @@ func (UnimplementedIcalBotServiceServer) UpdateCalendar(context.Context, *Update
 func (UnimplementedIcalBotServiceServer) DeleteCalendar(context.Context, *DeleteCalendarRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCalendar not implemented")
 }
+func (UnimplementedIcalBotServiceServer) SyncCalendar(context.Context, *SyncCalendarRequest) (*SyncCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCalendar not implemented")
+}
+func (UnimplementedIcalBotServiceServer) ListCalendarImports(context.Context, *ListCalendarImportsRequest) (*ListCalendarImportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalendarImports not implemented")
+}
+func (UnimplementedIcalBotServiceServer) PreviewCalendar(context.Context, *PreviewCalendarRequest) (*PreviewCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCalendar not implemented")
+}
+func (UnimplementedIcalBotServiceServer) ImportCalendarData(context.Context, *ImportCalendarDataRequest) (*Calendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCalendarData not implemented")
+}
 func (UnimplementedIcalBotServiceServer) GetChannel(context.Context, *GetChannelRequest) (*Channel, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
 }
@@ -273,6 +368,9 @@ func (UnimplementedIcalBotServiceServer) UpdateChannel(context.Context, *UpdateC
 func (UnimplementedIcalBotServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
 }
+func (UnimplementedIcalBotServiceServer) ListChannelCalendars(context.Context, *ListChannelCalendarsRequest) (*ListChannelCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannelCalendars not implemented")
+}
 func (UnimplementedIcalBotServiceServer) ListCalendarChannels(context.Context, *ListCalendarChannelsRequest) (*ListCalendarChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCalendarChannels not implemented")
 }
@@ -396,6 +494,78 @@ func _IcalBotService_DeleteCalendar_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IcalBotService_SyncCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IcalBotServiceServer).SyncCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IcalBotService_SyncCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IcalBotServiceServer).SyncCalendar(ctx, req.(*SyncCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IcalBotService_ListCalendarImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarImportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IcalBotServiceServer).ListCalendarImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IcalBotService_ListCalendarImports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IcalBotServiceServer).ListCalendarImports(ctx, req.(*ListCalendarImportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IcalBotService_PreviewCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IcalBotServiceServer).PreviewCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IcalBotService_PreviewCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IcalBotServiceServer).PreviewCalendar(ctx, req.(*PreviewCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IcalBotService_ImportCalendarData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCalendarDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IcalBotServiceServer).ImportCalendarData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IcalBotService_ImportCalendarData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IcalBotServiceServer).ImportCalendarData(ctx, req.(*ImportCalendarDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IcalBotService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetChannelRequest)
 	if err := dec(in); err != nil {
@@ -486,6 +656,24 @@ func _IcalBotService_DeleteChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IcalBotService_ListChannelCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IcalBotServiceServer).ListChannelCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IcalBotService_ListChannelCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IcalBotServiceServer).ListChannelCalendars(ctx, req.(*ListChannelCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IcalBotService_ListCalendarChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCalendarChannelsRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +762,22 @@ var IcalBotService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCalendar",
 			Handler:    _IcalBotService_DeleteCalendar_Handler,
 		},
+		{
+			MethodName: "SyncCalendar",
+			Handler:    _IcalBotService_SyncCalendar_Handler,
+		},
+		{
+			MethodName: "ListCalendarImports",
+			Handler:    _IcalBotService_ListCalendarImports_Handler,
+		},
+		{
+			MethodName: "PreviewCalendar",
+			Handler:    _IcalBotService_PreviewCalendar_Handler,
+		},
+		{
+			MethodName: "ImportCalendarData",
+			Handler:    _IcalBotService_ImportCalendarData_Handler,
+		},
 		{
 			MethodName: "GetChannel",
 			Handler:    _IcalBotService_GetChannel_Handler,
@@ -594,6 +798,10 @@ var IcalBotService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChannel",
 			Handler:    _IcalBotService_DeleteChannel_Handler,
 		},
+		{
+			MethodName: "ListChannelCalendars",
+			Handler:    _IcalBotService_ListChannelCalendars_Handler,
+		},
 		{
 			MethodName: "ListCalendarChannels",
 			Handler:    _IcalBotService_ListCalendarChannels_Handler,